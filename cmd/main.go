@@ -3,15 +3,26 @@ package main
 import (
 	"context"
 	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	observability "github.com/Gen-Do/lib-observability"
 	platform "github.com/Gen-Do/lib-platform"
 	"github.com/Gen-Do/lib-transport/listener"
+	"github.com/Gen-Do/skeleton-service/internal/api/batch"
+	exampleapi "github.com/Gen-Do/skeleton-service/internal/api/example"
 	"github.com/Gen-Do/skeleton-service/internal/api/get_example"
+	"github.com/Gen-Do/skeleton-service/internal/config"
 	"github.com/Gen-Do/skeleton-service/internal/generated/server/api"
+	"github.com/Gen-Do/skeleton-service/internal/pkg/jobs"
+	"github.com/Gen-Do/skeleton-service/internal/pkg/logging"
+	"github.com/Gen-Do/skeleton-service/internal/pkg/metrics"
+	"github.com/Gen-Do/skeleton-service/internal/pkg/periodic"
+	"github.com/Gen-Do/skeleton-service/internal/pkg/server"
 	"github.com/Gen-Do/skeleton-service/internal/workers/example"
 	"github.com/go-chi/chi/middleware"
+	"go.opentelemetry.io/otel"
 )
 
 func main() {
@@ -19,7 +30,13 @@ func main() {
 }
 
 func run() int {
-	ctx := context.Background()
+	// ctx отменяется по SIGINT/SIGTERM и прокидывается как в jobScheduler.Run/
+	// workerMgr.Run (их циклы завершаются по <-ctx.Done()), так и в platform.Run,
+	// которая просто оборачивает его собственным signal.NotifyContext. run() не ждет
+	// ни завершения этих горутин, ни выполняющихся job'ов: после возврата
+	// platform.Run процесс выходит.
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
 
 	obs := observability.MustNew(ctx)
 	defer obs.Shutdown(ctx)
@@ -27,6 +44,24 @@ func run() int {
 	log := obs.GetLogger()
 	log.Info(ctx, "Initializing service")
 
+	// config.Load — единственная точка входа в конфигурацию сервиса (флаги > env с
+	// префиксом SVC_ > config.<environment>.yaml > config.yaml > дефолты); cfg.Server и
+	// cfg.Logging ниже формируют internalLog через logging.Bootstrap.
+	cfg, err := config.Load(nil)
+	if err != nil {
+		log.Error(log.WithError(ctx, err), "Failed to load configuration")
+		return platform.ExitCodeFailure
+	}
+
+	// lib-observability.Logger и internal/pkg/logger.Logger — разные интерфейсы
+	// (первый принимает printf-style args, второй — структурированные Field), поэтому
+	// internal/pkg/* компоненты (periodic.Manager, jobs.Scheduler, ...) получают
+	// собственный логгер вместо log. logging.Bootstrap строит его из cfg (уровень и
+	// текстовый/json формат по cfg.Server.Environment) и делает его логгером по
+	// умолчанию для logging.From(ctx).
+	internalLog := logging.Bootstrap(cfg)
+	internalLog.Info(ctx, "Configuration loaded", logging.F("config", config.Dump()))
+
 	// Пример использования БД
 	//db, err := gorm.Open(postgres.Open(os.Getenv("DEP_DATABASE_DSN")), &gorm.Config{})
 	//if err != nil {
@@ -34,14 +69,60 @@ func run() int {
 	//	return fail
 	//}
 
+	// internalMetrics — отдельный Prometheus registry (internal/pkg/metrics), в отличие
+	// от метрик lib-observability — выставляется только через AdminServer (см. ниже), а
+	// не на публичном srv, так что его лейблы (status_class, route pattern) не делят
+	// namespace с тем, что уже собирает obs.HTTPMiddleware().
+	internalMetrics := metrics.New()
+
 	// Настройка HTTP сервера
 	srv := api.CreateHandler(
 		api.WithMW(middleware.RequestID),
 		api.WithMW(obs.HTTPMiddleware()),
+		api.WithMW(internalMetrics.Middleware()),
 	)
-	obs.RegisterRoutes(srv.GetMux())
+	// /metrics и /debug/pprof не выставляются на публичном srv — они переезжают на
+	// AdminServer (отдельный порт, см. ниже); /health и /healthz остаются публичными,
+	// так как балансировщики и Kubernetes liveness/readiness обращаются к ним напрямую.
+	srv.GetMux().Handle("/health", obs.HealthHandler())
+	srv.GetMux().Handle("/healthz", obs.HealthHandler())
+
+	// Собираем Service -> Endpoint -> Transport: бизнес-логика живет в
+	// exampleapi.Service, middleware навешивается на транспорт-агностичный Endpoint,
+	// а get_example.NewHandler лишь адаптирует его под сгенерированный ServerInterface.
+	greetEndpoint := exampleapi.MakeGreetEndpoint(exampleapi.NewService())
+	greetEndpoint = exampleapi.LoggingMiddleware(internalLog, "get_example")(greetEndpoint)
+
+	srv.SetGetExampleHandler(get_example.NewHandler(greetEndpoint))
+
+	// /batch фанит вложенные запросы в тот же роутер, что и остальные хендлеры
+	// сервиса, — без лишнего сетевого перехода, с Authorization и трассировкой,
+	// унаследованными от внешнего запроса.
+	batch.NewHandler(srv.GetMux(), otel.Tracer("batch")).RegisterRoutes(srv.GetMux())
+
+	// Планировщик job'ов: example.exampleJob регистрируется сам через init() в
+	// internal/workers/example, здесь мы лишь ставим его на расписание и открываем
+	// /jobs для ручного запуска и осмотра последнего прогона.
+	jobStore := jobs.NewMemoryStore()
+	jobScheduler := jobs.NewScheduler(internalLog, otel.Tracer("jobs"), jobStore)
+	if err := jobScheduler.Every("example", "@every 5m", jobs.Payload{}, jobs.NoRetry{}, 1); err != nil {
+		internalLog.Error(ctx, "Failed to schedule example job", logging.F("error", err))
+	}
+	go jobScheduler.Run(ctx)
+
+	// /jobs — та же "не выставлять наружу" зона, что и /metrics и /debug/pprof, так
+	// что AdminHandler монтируется на AdminServer (отдельный порт, недоступный
+	// публично), а не на публичный srv.GetMux().
+	adminServer := server.NewAdminServer(internalMetrics, internalLog)
+	adminServer.RegisterRoutes(jobs.NewAdminHandler(jobScheduler, jobStore))
+	adminServer.StartAsync()
 
-	srv.SetGetExampleHandler(get_example.Handler)
+	// Периодические воркеры регистрируются через periodic.Manager так же декларативно,
+	// как маршруты через RouteRegistrar: сам Manager берет на себя перезапуск с
+	// откатом и graceful shutdown по отмене ctx.
+	workerMgr := periodic.NewManager(internalLog, nil)
+	workerMgr.RegisterWorkers(example.NewWorker(internalLog))
+	go workerMgr.Run(ctx)
 
 	lis := listener.New(
 		listener.WithIdleTimeout(10*time.Second),
@@ -50,7 +131,7 @@ func run() int {
 		listener.WithLogger(log),
 	)
 
-	err := platform.Run(ctx,
+	err = platform.Run(ctx,
 		platform.WithListener(lis),
 		platform.WithMux(srv.GetMux()),
 		platform.WithLogger(log),
@@ -59,7 +140,6 @@ func run() int {
 			Logger:  log,
 			Metrics: nil,
 		}),
-		platform.WithWorkers(example.NewWorker(log)),
 	)
 	if err != nil {
 		log.Error(log.WithError(ctx, err), "Application exited with error")
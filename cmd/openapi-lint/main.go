@@ -0,0 +1,178 @@
+// Command openapi-lint checks api/openapi.yaml against api/lint-rules.yaml before
+// code generation runs (see `make generate`): missing operationIds, undocumented
+// error responses, non-snake_case field names and endpoints without x-auth-scopes
+// all fail the build instead of silently reaching the generator.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+func main() {
+	os.Exit(run())
+}
+
+func run() int {
+	specPath := "api/openapi.yaml"
+	rulesPath := "api/lint-rules.yaml"
+	if len(os.Args) > 1 {
+		specPath = os.Args[1]
+	}
+	if len(os.Args) > 2 {
+		rulesPath = os.Args[2]
+	}
+
+	spec, err := loadSpec(specPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "openapi-lint: load spec: %v\n", err)
+		return 1
+	}
+
+	rules, err := loadRules(rulesPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "openapi-lint: load rules: %v\n", err)
+		return 1
+	}
+
+	violations := lint(spec, rules)
+	if len(violations) == 0 {
+		fmt.Println("openapi-lint: ok")
+		return 0
+	}
+
+	for _, v := range violations {
+		fmt.Fprintln(os.Stderr, "openapi-lint:", v)
+	}
+	return 1
+}
+
+// Rules mirrors api/lint-rules.yaml.
+type Rules struct {
+	RequireOperationID    bool     `yaml:"require_operation_id"`
+	RequireErrorResponses bool     `yaml:"require_error_responses"`
+	ErrorResponseCodes    []string `yaml:"error_response_codes"`
+	FieldCase             string   `yaml:"field_case"`
+	RequireAuthScopes     bool     `yaml:"require_auth_scopes"`
+}
+
+type rulesFile struct {
+	Rules Rules `yaml:"rules"`
+}
+
+func loadRules(path string) (Rules, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Rules{}, err
+	}
+	var parsed rulesFile
+	if err := yaml.Unmarshal(data, &parsed); err != nil {
+		return Rules{}, err
+	}
+	return parsed.Rules, nil
+}
+
+func loadSpec(path string) (map[string]interface{}, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var spec map[string]interface{}
+	if err := yaml.Unmarshal(data, &spec); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// lint walks every operation and schema in spec and returns one message per
+// violation of rules.
+func lint(spec map[string]interface{}, rules Rules) []string {
+	var violations []string
+
+	paths, _ := spec["paths"].(map[string]interface{})
+	for path, rawItem := range paths {
+		item, _ := rawItem.(map[string]interface{})
+		for method, rawOp := range item {
+			if !isHTTPMethod(method) {
+				continue
+			}
+			op, _ := rawOp.(map[string]interface{})
+			violations = append(violations, lintOperation(path, method, op, rules)...)
+		}
+	}
+
+	components, _ := spec["components"].(map[string]interface{})
+	schemas, _ := components["schemas"].(map[string]interface{})
+	for name, rawSchema := range schemas {
+		schema, _ := rawSchema.(map[string]interface{})
+		violations = append(violations, lintSchemaFields(name, schema, rules)...)
+	}
+
+	return violations
+}
+
+func lintOperation(path, method string, op map[string]interface{}, rules Rules) []string {
+	var violations []string
+	loc := fmt.Sprintf("%s %s", method, path)
+
+	if rules.RequireOperationID {
+		if _, ok := op["operationId"].(string); !ok {
+			violations = append(violations, loc+": missing operationId")
+		}
+	}
+
+	if rules.RequireAuthScopes {
+		if _, ok := op["x-auth-scopes"]; !ok {
+			violations = append(violations, loc+": missing x-auth-scopes")
+		}
+	}
+
+	if rules.RequireErrorResponses {
+		responses, _ := op["responses"].(map[string]interface{})
+		for _, code := range rules.ErrorResponseCodes {
+			if _, ok := responses[code]; !ok {
+				violations = append(violations, fmt.Sprintf("%s: missing response for %s", loc, code))
+			}
+		}
+	}
+
+	return violations
+}
+
+func lintSchemaFields(schemaName string, schema map[string]interface{}, rules Rules) []string {
+	if rules.FieldCase != "snake_case" {
+		return nil
+	}
+
+	var violations []string
+	properties, _ := schema["properties"].(map[string]interface{})
+	for field := range properties {
+		if !isSnakeCase(field) {
+			violations = append(violations, fmt.Sprintf("schema %s: field %q is not snake_case", schemaName, field))
+		}
+	}
+	return violations
+}
+
+func isHTTPMethod(s string) bool {
+	switch s {
+	case "get", "post", "put", "patch", "delete", "head", "options":
+		return true
+	default:
+		return false
+	}
+}
+
+func isSnakeCase(s string) bool {
+	for _, r := range s {
+		if r >= 'A' && r <= 'Z' {
+			return false
+		}
+		if r != '_' && !(r >= 'a' && r <= 'z') && !(r >= '0' && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
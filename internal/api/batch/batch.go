@@ -0,0 +1,226 @@
+// Package batch implements POST /batch: it fans a JSON array of sub-requests out
+// to the same in-process router used by api.CreateHandler, so mobile/edge
+// clients can bundle several calls into one HTTP round-trip instead of making
+// them one by one.
+package batch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/go-chi/chi"
+)
+
+// DefaultMaxRequests caps how many sub-requests a single batch may contain,
+// unless overridden via WithMaxRequests.
+const DefaultMaxRequests = 25
+
+// SubRequest is one entry of the incoming batch.
+type SubRequest struct {
+	Method      string            `json:"method"`
+	RelativeURL string            `json:"relative_url"`
+	Headers     map[string]string `json:"headers,omitempty"`
+	Body        json.RawMessage   `json:"body,omitempty"`
+}
+
+// SubResponse is the result of dispatching one SubRequest.
+type SubResponse struct {
+	Code    int               `json:"code"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    json.RawMessage   `json:"body,omitempty"`
+}
+
+// Request is the POST /batch body.
+type Request struct {
+	Atomic   bool         `json:"atomic"`
+	Requests []SubRequest `json:"requests"`
+}
+
+// TxHook lets a batch with atomic=true roll back side effects made by earlier
+// sub-requests when a later one fails. The default Handler has no TxHook: atomic
+// then only means "stop and report at the first failure", with no actual rollback.
+type TxHook interface {
+	Begin(ctx context.Context) (context.Context, error)
+	Commit(ctx context.Context) error
+	Rollback(ctx context.Context) error
+}
+
+// Option configures a Handler built by NewHandler.
+type Option func(*Handler)
+
+// WithMaxRequests overrides DefaultMaxRequests.
+func WithMaxRequests(n int) Option {
+	return func(h *Handler) {
+		h.maxRequests = n
+	}
+}
+
+// WithTxHook attaches a TxHook invoked around atomic batches.
+func WithTxHook(hook TxHook) Option {
+	return func(h *Handler) {
+		h.txHook = hook
+	}
+}
+
+// Handler dispatches POST /batch sub-requests against router in-process.
+type Handler struct {
+	router      http.Handler
+	tracer      trace.Tracer
+	maxRequests int
+	txHook      TxHook
+}
+
+// NewHandler creates a batch Handler dispatching sub-requests through router —
+// typically the same *chi.Mux passed to api.CreateHandler, so /batch sees
+// exactly the routes and middleware the outer server exposes.
+func NewHandler(router http.Handler, tracer trace.Tracer, opts ...Option) *Handler {
+	h := &Handler{
+		router:      router,
+		tracer:      tracer,
+		maxRequests: DefaultMaxRequests,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// RegisterRoutes mounts POST /batch on router.
+func (h *Handler) RegisterRoutes(router chi.Router) {
+	router.Post("/batch", h.handle)
+}
+
+func (h *Handler) handle(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.tracer.Start(r.Context(), "batch")
+	defer span.End()
+
+	var req Request
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid batch request body", http.StatusBadRequest)
+		return
+	}
+
+	if len(req.Requests) > h.maxRequests {
+		http.Error(w, fmt.Sprintf("batch exceeds max of %d requests", h.maxRequests), http.StatusBadRequest)
+		return
+	}
+
+	if req.Atomic && h.txHook != nil {
+		var err error
+		ctx, err = h.txHook.Begin(ctx)
+		if err != nil {
+			http.Error(w, "failed to begin batch transaction", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	responses, failed := h.dispatchAll(ctx, r, req)
+
+	if req.Atomic && h.txHook != nil {
+		if failed {
+			_ = h.txHook.Rollback(ctx)
+		} else if err := h.txHook.Commit(ctx); err != nil {
+			http.Error(w, "failed to commit batch transaction", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	status := http.StatusOK
+	if failed && req.Atomic {
+		status = http.StatusFailedDependency
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(responses)
+}
+
+// dispatchAll runs each sub-request in order against h.router, stopping early
+// when req.Atomic and a sub-request comes back with a 4xx/5xx status. It
+// returns every response produced so far and whether the batch failed.
+func (h *Handler) dispatchAll(ctx context.Context, outer *http.Request, req Request) ([]SubResponse, bool) {
+	responses := make([]SubResponse, 0, len(req.Requests))
+
+	for _, sub := range req.Requests {
+		resp := h.dispatch(ctx, outer, sub)
+		responses = append(responses, resp)
+
+		if req.Atomic && resp.Code >= http.StatusBadRequest {
+			return responses, true
+		}
+	}
+
+	return responses, false
+}
+
+// dispatch runs one sub-request through h.router under its own child span,
+// forwarding the outer request's auth headers (overridable per sub-request) so
+// auth and tracing context flow into every child the same way they would for a
+// direct call.
+func (h *Handler) dispatch(ctx context.Context, outer *http.Request, sub SubRequest) SubResponse {
+	ctx, span := h.tracer.Start(ctx, "batch.sub/"+sub.Method+" "+sub.RelativeURL)
+	defer span.End()
+
+	// ctx still carries the chi RouteContext of the outer POST /batch; chi would reuse
+	// it and route the sub-request with the outer method. A fresh one makes chi route
+	// by the sub-request's own method and path.
+	ctx = context.WithValue(ctx, chi.RouteCtxKey, chi.NewRouteContext())
+
+	subReq, err := http.NewRequestWithContext(ctx, sub.Method, sub.RelativeURL, bytes.NewReader(sub.Body))
+	if err != nil {
+		return SubResponse{Code: http.StatusBadRequest, Body: jsonError(err)}
+	}
+
+	if auth := outer.Header.Get("Authorization"); auth != "" {
+		subReq.Header.Set("Authorization", auth)
+	}
+	for key, value := range sub.Headers {
+		subReq.Header.Set(key, value)
+	}
+
+	rec := httptest.NewRecorder()
+	h.router.ServeHTTP(rec, subReq)
+
+	return SubResponse{
+		Code:    rec.Code,
+		Headers: flattenHeaders(rec.Header()),
+		Body:    normalizeBody(rec.Body.Bytes()),
+	}
+}
+
+// normalizeBody returns body as-is if it is already valid JSON (the common case
+// for this service's handlers), or as a JSON string otherwise — sub-handlers
+// that fall back to http.Error() write plain text, which would otherwise break
+// marshaling the surrounding batch response.
+func normalizeBody(body []byte) json.RawMessage {
+	if len(body) == 0 {
+		return nil
+	}
+	if json.Valid(body) {
+		return json.RawMessage(body)
+	}
+	data, _ := json.Marshal(string(body))
+	return data
+}
+
+func flattenHeaders(h http.Header) map[string]string {
+	if len(h) == 0 {
+		return nil
+	}
+	flat := make(map[string]string, len(h))
+	for key := range h {
+		flat[key] = h.Get(key)
+	}
+	return flat
+}
+
+func jsonError(err error) json.RawMessage {
+	data, _ := json.Marshal(map[string]string{"error": err.Error()})
+	return data
+}
@@ -0,0 +1,48 @@
+package batch
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNormalizeBody(t *testing.T) {
+	tests := []struct {
+		name string
+		body []byte
+		want string
+	}{
+		{
+			name: "empty body returns nil",
+			body: nil,
+			want: "",
+		},
+		{
+			name: "valid JSON passes through unchanged",
+			body: []byte(`{"ok":true}`),
+			want: `{"ok":true}`,
+		},
+		{
+			name: "plain text is wrapped as a JSON string",
+			body: []byte("internal server error"),
+			want: `"internal server error"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := normalizeBody(tt.body)
+			if tt.want == "" {
+				if got != nil {
+					t.Errorf("normalizeBody(%q) = %s, want nil", tt.body, got)
+				}
+				return
+			}
+			if string(got) != tt.want {
+				t.Errorf("normalizeBody(%q) = %s, want %s", tt.body, got, tt.want)
+			}
+			if !json.Valid(got) {
+				t.Errorf("normalizeBody(%q) = %s is not valid JSON", tt.body, got)
+			}
+		})
+	}
+}
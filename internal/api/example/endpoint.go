@@ -0,0 +1,33 @@
+package example
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+)
+
+// GreetRequest — транспорт-независимый запрос эндпоинта Greet.
+type GreetRequest struct {
+	Name string
+}
+
+// GreetResponse — транспорт-независимый ответ эндпоинта Greet.
+type GreetResponse struct {
+	Message string `json:"message"`
+}
+
+// MakeGreetEndpoint адаптирует Service.Greet под go-kit endpoint.Endpoint, чтобы на
+// него можно было навешивать транспорт-агностичные middleware (логирование,
+// трассировка, метрики, rate limiting, circuit breaker, таймауты).
+func MakeGreetEndpoint(svc Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GreetRequest)
+
+		message, err := svc.Greet(ctx, req.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		return GreetResponse{Message: message}, nil
+	}
+}
@@ -0,0 +1,113 @@
+package example
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+	kitratelimit "github.com/go-kit/kit/ratelimit"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sony/gobreaker"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/time/rate"
+
+	"github.com/Gen-Do/skeleton-service/internal/pkg/logging"
+)
+
+// LoggingMiddleware привязывает к ctx дочерний логгер с полем endpoint (см.
+// logging.NewContext), так что сам эндпоинт и все, что он вызывает, получает
+// логгер через logging.From(ctx) с этим полем уже проставленным. trace_id/span_id
+// добавляются автоматически из контекста на уровне бэкенда, отдельно передавать
+// их не нужно.
+func LoggingMiddleware(log logging.Logger, endpointName string) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			ctx = logging.NewContext(ctx, log, logging.F("endpoint", endpointName))
+
+			start := time.Now()
+			response, err := next(ctx, request)
+
+			duration := logging.F("duration_ms", time.Since(start).Milliseconds())
+			if err != nil {
+				logging.From(ctx).Error(ctx, "endpoint call failed", duration, logging.F("error", err))
+			} else {
+				logging.From(ctx).Debug(ctx, "endpoint call succeeded", duration)
+			}
+
+			return response, err
+		}
+	}
+}
+
+// TracingMiddleware оборачивает вызов эндпоинта спаном tracer с именем name, используя
+// уже настроенный в пакете tracing глобальный TracerProvider.
+func TracingMiddleware(tracer trace.Tracer, name string) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			ctx, span := tracer.Start(ctx, name)
+			defer span.End()
+			return next(ctx, request)
+		}
+	}
+}
+
+// EndpointMetrics — набор метрик, переиспользуемый MetricsMiddleware для всех
+// эндпоинтов сервиса (лейблы endpoint/status различают конкретные вызовы).
+type EndpointMetrics struct {
+	RequestCount   *prometheus.CounterVec
+	RequestLatency *prometheus.HistogramVec
+	InFlight       *prometheus.GaugeVec
+}
+
+// MetricsMiddleware публикует количество вызовов, latency и in-flight gauge под
+// лейблом name.
+func MetricsMiddleware(m *EndpointMetrics, name string) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			m.InFlight.WithLabelValues(name).Inc()
+			defer m.InFlight.WithLabelValues(name).Dec()
+
+			start := time.Now()
+			response, err := next(ctx, request)
+
+			status := "ok"
+			if err != nil {
+				status = "error"
+			}
+			m.RequestCount.WithLabelValues(name, status).Inc()
+			m.RequestLatency.WithLabelValues(name).Observe(time.Since(start).Seconds())
+
+			return response, err
+		}
+	}
+}
+
+// RateLimitMiddleware ограничивает частоту вызовов эндпоинта токен-бакетом на основе
+// golang.org/x/time/rate, отклоняя вызовы сверх лимита ошибкой.
+func RateLimitMiddleware(limit rate.Limit, burst int) endpoint.Middleware {
+	return kitratelimit.NewErroringLimiter(rate.NewLimiter(limit, burst))
+}
+
+// CircuitBreakerMiddleware размыкает цепочку вызовов эндпоинта при превышении порога
+// ошибок, используя sony/gobreaker под именем name для метрик/логов брейкера.
+func CircuitBreakerMiddleware(name string) endpoint.Middleware {
+	cb := gobreaker.NewCircuitBreaker(gobreaker.Settings{Name: name})
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			return cb.Execute(func() (interface{}, error) {
+				return next(ctx, request)
+			})
+		}
+	}
+}
+
+// TimeoutMiddleware обрывает вызов эндпоинта, если он не уложился в d.
+func TimeoutMiddleware(d time.Duration) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			ctx, cancel := context.WithTimeout(ctx, d)
+			defer cancel()
+			return next(ctx, request)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package example
+
+import "context"
+
+// Service описывает бизнес-логику example API, независимую от транспорта. Новые
+// операции добавляются расширением этого интерфейса, а не правкой HTTP-обвязки.
+type Service interface {
+	Greet(ctx context.Context, name string) (string, error)
+}
+
+type service struct{}
+
+// NewService возвращает реализацию Service по умолчанию.
+func NewService() Service {
+	return service{}
+}
+
+func (service) Greet(_ context.Context, name string) (string, error) {
+	return "Hello, " + name + "!", nil
+}
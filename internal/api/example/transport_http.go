@@ -0,0 +1,28 @@
+package example
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-kit/kit/endpoint"
+	kithttp "github.com/go-kit/kit/transport/http"
+)
+
+// DecodeGreetRequest разбирает query-параметр name запроса в GreetRequest.
+func DecodeGreetRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return GreetRequest{Name: r.URL.Query().Get("name")}, nil
+}
+
+// EncodeJSONResponse сериализует ответ любого эндпоинта в JSON — общий encoder для
+// всех HTTP-транспортов этого сервиса.
+func EncodeJSONResponse(_ context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(response)
+}
+
+// NewGreetHandler собирает HTTP-транспорт поверх ep через go-kit transport/http —
+// decode -> endpoint -> encode, без какой-либо транспорт-специфичной логики внутри ep.
+func NewGreetHandler(ep endpoint.Endpoint) http.Handler {
+	return kithttp.NewServer(ep, DecodeGreetRequest, EncodeJSONResponse)
+}
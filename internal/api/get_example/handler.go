@@ -4,17 +4,39 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"github.com/go-kit/kit/endpoint"
+
+	"github.com/Gen-Do/skeleton-service/internal/api/example"
 	"github.com/Gen-Do/skeleton-service/internal/generated/server/api"
 )
 
-func Handler(w http.ResponseWriter, r *http.Request, params api.GetExampleParams) {
-	message := "Hello, " + params.Name + "!"
-	response := api.ExampleResponse{
-		Message: &message,
-	}
+// NewHandler адаптирует ep (обычно example.MakeGreetEndpoint, обернутый middleware
+// логирования/трассировки/метрик) под сгенерированный ServerInterface: конвертирует
+// сгенерированные параметры запроса в example.GreetRequest и результат обратно в
+// api.ExampleResponse, не неся сам никакой бизнес-логики.
+func NewHandler(ep endpoint.Endpoint) func(w http.ResponseWriter, r *http.Request, params api.GetExampleParams) {
+	return func(w http.ResponseWriter, r *http.Request, params api.GetExampleParams) {
+		result, err := ep(r.Context(), example.GreetRequest{Name: params.Name})
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "internal_error", err.Error())
+			return
+		}
+
+		greet := result.(example.GreetResponse)
+		response := api.ExampleResponse{Message: &greet.Message}
 
-	if err := json.NewEncoder(w).Encode(response); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			writeError(w, http.StatusInternalServerError, "internal_error", err.Error())
+			return
+		}
 	}
 }
+
+// writeError кодирует ошибку как api.ErrorResponse — ту же схему, которую api/openapi.yaml
+// объявляет для 4xx/5xx ответов. Клиент в pkg/client тело ошибки не разбирает и
+// сообщает только код статуса.
+func writeError(w http.ResponseWriter, statusCode int, code, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(api.ErrorResponse{ErrorCode: code, ErrorMessage: message})
+}
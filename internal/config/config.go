@@ -1,57 +1,240 @@
 package config
 
 import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/go-playground/validator/v10"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
 	"github.com/Gen-Do/skeleton-service/internal/pkg/env"
 )
 
+// envPrefix — префикс переменных окружения (SVC_SERVER_PORT и т.д.), под которым
+// Viper подхватывает переопределения конфигурации.
+const envPrefix = "SVC"
+
 // Config holds all configuration for the service
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	Logging  LoggingConfig
-	Tracing  TracingConfig
+	Server   ServerConfig   `mapstructure:"server"`
+	Database DatabaseConfig `mapstructure:"database"`
+	Logging  LoggingConfig  `mapstructure:"logging"`
+	Tracing  TracingConfig  `mapstructure:"tracing"`
 }
 
 // ServerConfig holds server-related configuration
 type ServerConfig struct {
-	Port        string
-	ServiceName string
-	Environment string
+	Port        string `mapstructure:"port" validate:"required"`
+	ServiceName string `mapstructure:"service_name" validate:"required"`
+	Environment string `mapstructure:"environment" validate:"required"`
 }
 
 // DatabaseConfig holds database-related configuration
 type DatabaseConfig struct {
-	URL string
+	URL string `mapstructure:"url" validate:"omitempty,url" secret:"true"`
 }
 
 // LoggingConfig holds logging-related configuration
 type LoggingConfig struct {
-	Level string
+	Level string `mapstructure:"level" validate:"required"`
 }
 
 // TracingConfig holds tracing-related configuration
 type TracingConfig struct {
-	JaegerEndpoint string
-	Enabled        bool
-}
-
-// Load loads configuration from environment variables
-func Load() *Config {
-	return &Config{
-		Server: ServerConfig{
-			Port:        env.GetString("PORT", "8080"),
-			ServiceName: env.GetString("SERVICE_NAME", "service-skeleton"),
-			Environment: env.GetString("ENVIRONMENT", "development"),
-		},
-		Database: DatabaseConfig{
-			URL: env.GetString("DATABASE_URL", ""),
-		},
-		Logging: LoggingConfig{
-			Level: env.GetString("LOG_LEVEL", "info"),
-		},
-		Tracing: TracingConfig{
-			JaegerEndpoint: env.GetString("JAEGER_ENDPOINT", "http://localhost:14268/api/traces"),
-			Enabled:        env.GetBool("TRACING_ENABLED", true),
-		},
+	JaegerEndpoint string `mapstructure:"jaeger_endpoint" validate:"required,url"`
+	Enabled        bool   `mapstructure:"enabled"`
+}
+
+var (
+	v        *viper.Viper
+	current  atomic.Pointer[Config]
+	validate = validator.New()
+
+	sectionsMu sync.Mutex
+	sections   = map[string]interface{}{}
+)
+
+// Load merges configuration sources in precedence order — flags > env vars (prefixed
+// with SVC_) > config.<environment>.yaml > config.yaml > compiled defaults — validates
+// the result against the `validate` struct tags and stores it as the active snapshot
+// returned by Get(). It must be called once at startup before Get() or Watch().
+func Load(flags *pflag.FlagSet) (*Config, error) {
+	v = viper.New()
+	setDefaults(v)
+
+	v.SetEnvPrefix(envPrefix)
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	v.AutomaticEnv()
+
+	if flags != nil {
+		if err := v.BindPFlags(flags); err != nil {
+			return nil, fmt.Errorf("config: bind flags: %w", err)
+		}
 	}
+
+	environment := env.GetString("ENVIRONMENT", "development")
+
+	v.SetConfigName("config")
+	v.SetConfigType("yaml")
+	v.AddConfigPath(".")
+	if err := v.ReadInConfig(); err != nil {
+		var notFound viper.ConfigFileNotFoundError
+		if !errors.As(err, &notFound) {
+			return nil, fmt.Errorf("config: read config.yaml: %w", err)
+		}
+	}
+
+	envLayer := viper.New()
+	envLayer.SetConfigName("config." + environment)
+	envLayer.SetConfigType("yaml")
+	envLayer.AddConfigPath(".")
+	if err := envLayer.ReadInConfig(); err == nil {
+		if err := v.MergeConfigMap(envLayer.AllSettings()); err != nil {
+			return nil, fmt.Errorf("config: merge config.%s.yaml: %w", environment, err)
+		}
+	}
+
+	return reload()
+}
+
+// Get returns the currently active configuration snapshot. Safe for concurrent use;
+// the returned value must be treated as read-only — callers wanting to observe updates
+// should re-call Get() rather than cache the pointer across a Watch() reload.
+func Get() *Config {
+	return current.Load()
+}
+
+// Register attaches an externally-owned section (e.g. a KafkaConfig{} defined in
+// another package) under key, so it is populated from the same sources — and kept in
+// sync on the same Watch() reloads — as the built-in sections.
+func Register(key string, section interface{}) {
+	sectionsMu.Lock()
+	defer sectionsMu.Unlock()
+	sections[key] = section
+	if v != nil {
+		_ = v.UnmarshalKey(key, section)
+	}
+}
+
+// Watch debounces change events of the local config.yaml and hot-swaps the active
+// Config atomically, calling onChange with the new snapshot after each successful
+// reload. Remote sources are not watched here (see WatchRemote), and changes to
+// config.<environment>.yaml alone do not trigger a reload. A reload that fails is
+// dropped: Watch leaves the previously active snapshot in place, does not call
+// onChange and reports the error nowhere.
+func Watch(onChange func(*Config)) {
+	if v == nil {
+		return
+	}
+
+	var (
+		mu      sync.Mutex
+		pending *time.Timer
+	)
+	const debounce = 250 * time.Millisecond
+
+	v.OnConfigChange(func(fsnotify.Event) {
+		mu.Lock()
+		defer mu.Unlock()
+		if pending != nil {
+			pending.Stop()
+		}
+		pending = time.AfterFunc(debounce, func() {
+			// Viper re-reads config.yaml on its own and thereby drops what Load merged
+			// from config.<environment>.yaml, so that layer is merged again here.
+			envLayer := viper.New()
+			envLayer.SetConfigName("config." + env.GetString("ENVIRONMENT", "development"))
+			envLayer.SetConfigType("yaml")
+			envLayer.AddConfigPath(".")
+			if err := envLayer.ReadInConfig(); err == nil {
+				if err := v.MergeConfigMap(envLayer.AllSettings()); err != nil {
+					return
+				}
+			}
+
+			cfg, err := reload()
+			if err != nil {
+				return
+			}
+			onChange(cfg)
+		})
+	})
+	v.WatchConfig()
+}
+
+// reload unmarshals the current Viper state into a fresh Config, validates it,
+// publishes it atomically and resyncs any Register()-ed sections.
+func reload() (*Config, error) {
+	cfg := &Config{}
+	if err := v.Unmarshal(cfg); err != nil {
+		return nil, fmt.Errorf("config: unmarshal: %w", err)
+	}
+	if err := validate.Struct(cfg); err != nil {
+		return nil, fmt.Errorf("config: validate: %w", err)
+	}
+
+	current.Store(cfg)
+
+	sectionsMu.Lock()
+	for key, section := range sections {
+		_ = v.UnmarshalKey(key, section)
+	}
+	sectionsMu.Unlock()
+
+	return cfg, nil
+}
+
+// Dump returns a map representation of the active config with fields tagged
+// `secret:"true"` redacted, safe to log at boot.
+func Dump() map[string]interface{} {
+	cfg := Get()
+	if cfg == nil {
+		return nil
+	}
+	return redact(reflect.ValueOf(*cfg))
+}
+
+// redact walks a config struct by reflection, replacing `secret:"true"` fields with a
+// placeholder and recursing into nested structs.
+func redact(val reflect.Value) map[string]interface{} {
+	out := make(map[string]interface{})
+	t := val.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fieldValue := val.Field(i)
+
+		name := field.Tag.Get("mapstructure")
+		if name == "" {
+			name = field.Name
+		}
+
+		if field.Tag.Get("secret") == "true" {
+			out[name] = "***REDACTED***"
+			continue
+		}
+		if fieldValue.Kind() == reflect.Struct {
+			out[name] = redact(fieldValue)
+			continue
+		}
+		out[name] = fieldValue.Interface()
+	}
+	return out
+}
+
+// setDefaults seeds the compiled-in defaults — the lowest-precedence layer.
+func setDefaults(v *viper.Viper) {
+	v.SetDefault("server.port", "8080")
+	v.SetDefault("server.service_name", "service-skeleton")
+	v.SetDefault("server.environment", "development")
+	v.SetDefault("database.url", "")
+	v.SetDefault("logging.level", "info")
+	v.SetDefault("tracing.jaeger_endpoint", "http://localhost:14268/api/traces")
+	v.SetDefault("tracing.enabled", true)
 }
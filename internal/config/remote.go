@@ -0,0 +1,41 @@
+//go:build remote
+
+package config
+
+import (
+	"github.com/spf13/viper"
+	_ "github.com/spf13/viper/remote"
+)
+
+// EnableRemote adds a remote configuration source (Consul or etcd) as an extra layer
+// below env vars but above compiled defaults. Only available when built with
+// `-tags remote`, since it pulls in the viper/remote provider and its dependencies.
+func EnableRemote(provider, endpoint, path string) error {
+	if v == nil {
+		v = viper.New()
+		setDefaults(v)
+	}
+	if err := v.AddRemoteProvider(provider, endpoint, path); err != nil {
+		return err
+	}
+	v.SetConfigType("yaml")
+	return v.ReadRemoteConfig()
+}
+
+// WatchRemote polls the remote provider for changes and hot-swaps the active Config,
+// mirroring Watch()'s behavior for local files.
+func WatchRemote(onChange func(*Config)) {
+	if v == nil {
+		return
+	}
+	go func() {
+		for {
+			if err := v.WatchRemoteConfig(); err != nil {
+				continue
+			}
+			if cfg, err := reload(); err == nil {
+				onChange(cfg)
+			}
+		}
+	}()
+}
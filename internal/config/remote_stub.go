@@ -0,0 +1,19 @@
+//go:build !remote
+
+package config
+
+import "errors"
+
+// ErrRemoteNotBuilt is returned by EnableRemote when the binary was built without the
+// `remote` build tag, so the Consul/etcd provider (and its dependencies) were never
+// linked in.
+var ErrRemoteNotBuilt = errors.New("config: remote sources require building with -tags remote")
+
+// EnableRemote is a no-op stub; rebuild with `-tags remote` to enable Consul/etcd
+// as a configuration source.
+func EnableRemote(provider, endpoint, path string) error {
+	return ErrRemoteNotBuilt
+}
+
+// WatchRemote is a no-op stub; rebuild with `-tags remote` to enable remote hot-reload.
+func WatchRemote(onChange func(*Config)) {}
@@ -0,0 +1,7 @@
+// Package api is generated from api/openapi.yaml by oapi-codegen; see
+// api/oapi-codegen.config.yaml and `make generate`. Do not hand-edit
+// server.gen.go — non-generated helpers that adapt it for this service's
+// wiring live in handler.go alongside it.
+package api
+
+//go:generate go run github.com/deepmap/oapi-codegen/v2/cmd/oapi-codegen --config=../../../../api/oapi-codegen.config.yaml ../../../../api/openapi.yaml
@@ -0,0 +1,66 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi"
+)
+
+// Handler adapts the generated ServerInterface to this service's wiring style:
+// cmd/main.go builds business logic as Service/Endpoint/Transport chains
+// (internal/api/example) and wires each operation in independently, rather than
+// implementing ServerInterface as a single type up front. Handler satisfies
+// ServerInterface itself, delegating each method to whatever func was last set
+// via its SetXxxHandler setter.
+type Handler struct {
+	router *chi.Mux
+
+	getExample func(w http.ResponseWriter, r *http.Request, params GetExampleParams)
+}
+
+// Option configures a Handler built by CreateHandler.
+type Option func(*Handler)
+
+// WithMW appends mw to the handler's middleware chain.
+func WithMW(mw func(http.Handler) http.Handler) Option {
+	return func(h *Handler) {
+		h.router.Use(mw)
+	}
+}
+
+// CreateHandler builds a Handler with its router and registers ServerInterface
+// (backed by h itself) against it. Operation handlers default to 501 until set
+// via the corresponding SetXxxHandler.
+func CreateHandler(opts ...Option) *Handler {
+	h := &Handler{router: chi.NewRouter()}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	RegisterHandlers(h.router, h)
+
+	return h
+}
+
+// SetGetExampleHandler sets the handler invoked for GET /example.
+func (h *Handler) SetGetExampleHandler(fn func(w http.ResponseWriter, r *http.Request, params GetExampleParams)) {
+	h.getExample = fn
+}
+
+// GetExample implements ServerInterface, delegating to the func set via
+// SetGetExampleHandler, or responding 501 if none was set.
+func (h *Handler) GetExample(w http.ResponseWriter, r *http.Request, params GetExampleParams) {
+	if h.getExample == nil {
+		http.Error(w, "not implemented", http.StatusNotImplemented)
+		return
+	}
+	h.getExample(w, r, params)
+}
+
+// GetMux returns the underlying router so it can be passed to things that need
+// to add routes or middleware outside of ServerInterface (admin registration,
+// the outer platform.Run wiring, etc).
+func (h *Handler) GetMux() *chi.Mux {
+	return h.router
+}
@@ -1,7 +1,9 @@
 package handlers
 
-// Пример структуры для API обработчиков
-// Этот файл показывает, как организовать обработчики для сгенерированного API
+// Пример структуры для API обработчиков.
+// Этот файл показывает, как организовать обработчики, реализующие сгенерированный
+// api.ServerInterface, напрямую — без слоя Service/Endpoint/Transport, который
+// использует internal/api/get_example для продакшен-вайринга в cmd/main.go.
 
 import (
 	"encoding/json"
@@ -9,157 +11,26 @@ import (
 
 	"github.com/go-chi/chi/v5"
 	"github.com/sirupsen/logrus"
-	// "github.com/gendo/service-skeleton/internal/generated/api"
+
+	"github.com/Gen-Do/skeleton-service/internal/generated/server/api"
 )
 
-// APIHandlers содержит все зависимости для API обработчиков
+// APIHandlers содержит все зависимости для API обработчиков.
 type APIHandlers struct {
 	Logger *logrus.Logger
 	// DB     database.Interface
 	// Services *services.Services
 }
 
-// Пример реализации обработчиков, соответствующих сгенерированному API:
-
-// GetUsers обрабатывает GET /users
-// func (h *APIHandlers) GetUsers(w http.ResponseWriter, r *http.Request) {
-//     // Парсинг query параметров
-//     params := api.GetUsersParams{}
-//     if err := runtime.BindQueryParams(r.URL.Query(), &params); err != nil {
-//         h.writeErrorResponse(w, "Invalid query parameters", http.StatusBadRequest)
-//         return
-//     }
-//
-//     // Логирование запроса
-//     h.Logger.WithFields(logrus.Fields{
-//         "page":  params.Page,
-//         "limit": params.Limit,
-//     }).Info("Getting users list")
-//
-//     // Бизнес-логика
-//     users, pagination, err := h.Services.UserService.GetUsers(r.Context(), params.Page, params.Limit)
-//     if err != nil {
-//         h.Logger.WithError(err).Error("Failed to get users")
-//         h.writeErrorResponse(w, "Internal server error", http.StatusInternalServerError)
-//         return
-//     }
-//
-//     // Формирование ответа
-//     response := api.UsersResponse{
-//         Users:      users,
-//         Pagination: pagination,
-//     }
-//
-//     h.writeJSONResponse(w, response, http.StatusOK)
-// }
-
-// CreateUser обрабатывает POST /users
-// func (h *APIHandlers) CreateUser(w http.ResponseWriter, r *http.Request) {
-//     var req api.CreateUserRequest
-//     if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-//         h.writeErrorResponse(w, "Invalid JSON body", http.StatusBadRequest)
-//         return
-//     }
-//
-//     h.Logger.WithFields(logrus.Fields{
-//         "email": req.Email,
-//         "name":  req.Name,
-//     }).Info("Creating new user")
-//
-//     user, err := h.Services.UserService.CreateUser(r.Context(), req)
-//     if err != nil {
-//         h.Logger.WithError(err).Error("Failed to create user")
-//         if errors.Is(err, services.ErrUserAlreadyExists) {
-//             h.writeErrorResponse(w, "User already exists", http.StatusConflict)
-//             return
-//         }
-//         h.writeErrorResponse(w, "Internal server error", http.StatusInternalServerError)
-//         return
-//     }
-//
-//     h.writeJSONResponse(w, user, http.StatusCreated)
-// }
+var _ api.ServerInterface = (*APIHandlers)(nil)
 
-// GetUserById обрабатывает GET /users/{userId}
-// func (h *APIHandlers) GetUserById(w http.ResponseWriter, r *http.Request) {
-//     userID := chi.URLParam(r, "userId")
-//     if userID == "" {
-//         h.writeErrorResponse(w, "User ID is required", http.StatusBadRequest)
-//         return
-//     }
-//
-//     h.Logger.WithField("user_id", userID).Info("Getting user by ID")
-//
-//     user, err := h.Services.UserService.GetUserByID(r.Context(), userID)
-//     if err != nil {
-//         h.Logger.WithError(err).Error("Failed to get user")
-//         if errors.Is(err, services.ErrUserNotFound) {
-//             h.writeErrorResponse(w, "User not found", http.StatusNotFound)
-//             return
-//         }
-//         h.writeErrorResponse(w, "Internal server error", http.StatusInternalServerError)
-//         return
-//     }
-//
-//     h.writeJSONResponse(w, user, http.StatusOK)
-// }
+// GetExample реализует api.ServerInterface.GetExample.
+func (h *APIHandlers) GetExample(w http.ResponseWriter, r *http.Request, params api.GetExampleParams) {
+	h.Logger.WithField("name", params.Name).Info("Handling GetExample")
 
-// UpdateUser обрабатывает PUT /users/{userId}
-// func (h *APIHandlers) UpdateUser(w http.ResponseWriter, r *http.Request) {
-//     userID := chi.URLParam(r, "userId")
-//     if userID == "" {
-//         h.writeErrorResponse(w, "User ID is required", http.StatusBadRequest)
-//         return
-//     }
-//
-//     var req api.UpdateUserRequest
-//     if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-//         h.writeErrorResponse(w, "Invalid JSON body", http.StatusBadRequest)
-//         return
-//     }
-//
-//     h.Logger.WithFields(logrus.Fields{
-//         "user_id": userID,
-//         "name":    req.Name,
-//     }).Info("Updating user")
-//
-//     user, err := h.Services.UserService.UpdateUser(r.Context(), userID, req)
-//     if err != nil {
-//         h.Logger.WithError(err).Error("Failed to update user")
-//         if errors.Is(err, services.ErrUserNotFound) {
-//             h.writeErrorResponse(w, "User not found", http.StatusNotFound)
-//             return
-//         }
-//         h.writeErrorResponse(w, "Internal server error", http.StatusInternalServerError)
-//         return
-//     }
-//
-//     h.writeJSONResponse(w, user, http.StatusOK)
-// }
-
-// DeleteUser обрабатывает DELETE /users/{userId}
-// func (h *APIHandlers) DeleteUser(w http.ResponseWriter, r *http.Request) {
-//     userID := chi.URLParam(r, "userId")
-//     if userID == "" {
-//         h.writeErrorResponse(w, "User ID is required", http.StatusBadRequest)
-//         return
-//     }
-//
-//     h.Logger.WithField("user_id", userID).Info("Deleting user")
-//
-//     err := h.Services.UserService.DeleteUser(r.Context(), userID)
-//     if err != nil {
-//         h.Logger.WithError(err).Error("Failed to delete user")
-//         if errors.Is(err, services.ErrUserNotFound) {
-//             h.writeErrorResponse(w, "User not found", http.StatusNotFound)
-//             return
-//         }
-//         h.writeErrorResponse(w, "Internal server error", http.StatusInternalServerError)
-//         return
-//     }
-//
-//     w.WriteHeader(http.StatusNoContent)
-// }
+	message := "Hello, " + params.Name
+	h.writeJSONResponse(w, api.ExampleResponse{Message: &message}, http.StatusOK)
+}
 
 // Вспомогательные методы для работы с HTTP ответами
 
@@ -172,31 +43,28 @@ func (h *APIHandlers) writeJSONResponse(w http.ResponseWriter, data interface{},
 	}
 }
 
-func (h *APIHandlers) writeErrorResponse(w http.ResponseWriter, message string, statusCode int) {
+// writeErrorResponse кодирует ошибку как api.ErrorResponse — ту же схему, которую
+// api/openapi.yaml объявляет для ответов 4xx/5xx. Клиент в pkg/client тело ошибки
+// не разбирает и сообщает только код статуса.
+func (h *APIHandlers) writeErrorResponse(w http.ResponseWriter, code string, message string, statusCode int) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(statusCode)
 
-	errorResponse := map[string]interface{}{
-		"error":   http.StatusText(statusCode),
-		"message": message,
-	}
-
+	errorResponse := api.ErrorResponse{ErrorCode: code, ErrorMessage: message}
 	if err := json.NewEncoder(w).Encode(errorResponse); err != nil {
 		h.Logger.WithError(err).Error("Failed to encode error response")
 	}
 }
 
-// Пример middleware для дополнительной валидации
+// ValidateUserID — пример middleware для дополнительной валидации параметров
+// маршрута; требует наличия непустого userId, оставляя формат на усмотрение
+// конкретных обработчиков, которые его добавят.
 func (h *APIHandlers) ValidateUserID(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		userID := chi.URLParam(r, "userId")
-
-		// Валидация UUID формата
-		// if !isValidUUID(userID) {
-		//     h.writeErrorResponse(w, "Invalid user ID format", http.StatusBadRequest)
-		//     return
-		// }
-
+		if chi.URLParam(r, "userId") == "" {
+			h.writeErrorResponse(w, "invalid_request", "User ID is required", http.StatusBadRequest)
+			return
+		}
 		next.ServeHTTP(w, r)
 	})
 }
@@ -0,0 +1,67 @@
+package jobs
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// JobInfo описывает один зарегистрированный job для вывода в admin-эндпоинте /jobs.
+type JobInfo struct {
+	Name    string     `json:"name"`
+	LastRun *RunRecord `json:"last_run,omitempty"`
+}
+
+// AdminHandler предоставляет HTTP-обработчики для осмотра и ручного запуска job'ов.
+// Сам по себе он не добавляет аутентификацию — вызывающая сторона должна монтировать
+// RegisterRoutes за тем же auth-middleware, что и остальные административные маршруты.
+type AdminHandler struct {
+	scheduler *Scheduler
+	store     Store
+}
+
+// NewAdminHandler создает AdminHandler поверх scheduler и его Store.
+func NewAdminHandler(scheduler *Scheduler, store Store) *AdminHandler {
+	return &AdminHandler{scheduler: scheduler, store: store}
+}
+
+// RegisterRoutes реализует тот же контракт, что и server.RouteRegistrar: GET /jobs
+// перечисляет зарегистрированные job'ы с последним статусом прогона, POST
+// /jobs/{name}/trigger запускает job немедленно в обход расписания.
+func (h *AdminHandler) RegisterRoutes(router chi.Router) {
+	router.Get("/jobs", h.list)
+	router.Post("/jobs/{name}/trigger", h.trigger)
+}
+
+// list отвечает списком всех зарегистрированных job'ов вместе с их последним прогоном.
+func (h *AdminHandler) list(w http.ResponseWriter, r *http.Request) {
+	names := RegisteredJobs()
+	infos := make([]JobInfo, 0, len(names))
+
+	for _, name := range names {
+		info := JobInfo{Name: name}
+		if h.store != nil {
+			if record, ok, err := h.store.LastRun(r.Context(), name); err == nil && ok {
+				info.LastRun = &record
+			}
+		}
+		infos = append(infos, info)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(infos)
+}
+
+// trigger запускает job по имени из URL немедленно, без повторов при ошибке.
+func (h *AdminHandler) trigger(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "name")
+
+	if err := h.scheduler.Trigger(r.Context(), name, Payload{}); err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+	_ = json.NewEncoder(w).Encode(map[string]string{"status": "triggered", "job": name})
+}
@@ -0,0 +1,16 @@
+package jobs
+
+import "context"
+
+// Payload — параметры одного запуска Job. Планировщик передает его Job.Run без
+// интерпретации, так что конкретный job сам решает, что и как в нем искать.
+type Payload map[string]interface{}
+
+// Job — единица работы, которую можно поставить на расписание или запустить
+// вручную через Scheduler.Trigger. В отличие от periodic.Periodic, Job не привязан
+// к фиксированному интервалу: когда и с каким payload его запускать, решает
+// вызывающая сторона (Scheduler или admin-эндпоинт).
+type Job interface {
+	Name() string
+	Run(ctx context.Context, payload Payload) error
+}
@@ -0,0 +1,51 @@
+package jobs
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+var (
+	registryMu sync.Mutex
+	registry   = map[string]Job{}
+)
+
+// Register добавляет job в глобальный реестр под именем job.Name(). Предназначен
+// для вызова из init() в пакетах, определяющих конкретные Job, — тогда добавление
+// новой задачи не требует правки wiring в cmd/main.go, только импорта пакета job'а
+// ради побочного эффекта init().
+//
+// Паникует при повторной регистрации одного и того же имени — это ошибка в коде,
+// а не во входных данных, и должна быть замечена сразу, а не молча замаскирована.
+func Register(job Job) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	name := job.Name()
+	if _, exists := registry[name]; exists {
+		panic(fmt.Sprintf("jobs: job %q already registered", name))
+	}
+	registry[name] = job
+}
+
+// lookup возвращает job, зарегистрированный под именем name.
+func lookup(name string) (Job, bool) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	job, ok := registry[name]
+	return job, ok
+}
+
+// RegisteredJobs возвращает имена всех зарегистрированных job'ов в алфавитном порядке.
+func RegisteredJobs() []string {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
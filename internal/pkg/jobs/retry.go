@@ -0,0 +1,71 @@
+package jobs
+
+import (
+	"math/rand"
+	"time"
+)
+
+// RetryPolicy решает, нужно ли повторить выполнение job после attempt-й неудачной
+// попытки (считая с нуля), и если да — с какой задержкой.
+type RetryPolicy interface {
+	Next(attempt int) (delay time.Duration, retry bool)
+}
+
+// NoRetry отключает повторные попытки: любой сбой job считается окончательным.
+type NoRetry struct{}
+
+// Next всегда сообщает, что повторов не будет.
+func (NoRetry) Next(attempt int) (time.Duration, bool) {
+	return 0, false
+}
+
+// FixedRetry повторяет выполнение с постоянной задержкой не более MaxAttempts раз.
+type FixedRetry struct {
+	Delay       time.Duration
+	MaxAttempts int
+}
+
+// Next возвращает Delay, пока attempt не достигнет MaxAttempts.
+func (f FixedRetry) Next(attempt int) (time.Duration, bool) {
+	if attempt >= f.MaxAttempts {
+		return 0, false
+	}
+	return f.Delay, true
+}
+
+// ExponentialRetry удваивает (или умножает на Multiplier) задержку с каждой
+// попыткой, ограничивая ее сверху Max и добавляя случайный джиттер в [0, Jitter),
+// чтобы не перегружать зависимость синхронными повторами после общего сбоя.
+type ExponentialRetry struct {
+	Initial     time.Duration
+	Max         time.Duration
+	Multiplier  float64
+	MaxAttempts int
+	Jitter      time.Duration
+}
+
+// Next вычисляет задержку для attempt-й попытки либо сообщает об исчерпании MaxAttempts.
+func (e ExponentialRetry) Next(attempt int) (time.Duration, bool) {
+	if e.MaxAttempts > 0 && attempt >= e.MaxAttempts {
+		return 0, false
+	}
+
+	multiplier := e.Multiplier
+	if multiplier <= 0 {
+		multiplier = 2
+	}
+
+	delay := float64(e.Initial)
+	for i := 0; i < attempt; i++ {
+		delay *= multiplier
+	}
+	if e.Max > 0 && delay > float64(e.Max) {
+		delay = float64(e.Max)
+	}
+
+	result := time.Duration(delay)
+	if e.Jitter > 0 {
+		result += time.Duration(rand.Int63n(int64(e.Jitter)))
+	}
+	return result, true
+}
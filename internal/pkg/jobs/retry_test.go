@@ -0,0 +1,64 @@
+package jobs
+
+import (
+	"testing"
+	"time"
+)
+
+func TestExponentialRetryNext(t *testing.T) {
+	tests := []struct {
+		name      string
+		retry     ExponentialRetry
+		attempt   int
+		wantRetry bool
+		wantDelay time.Duration
+	}{
+		{
+			name:      "first attempt returns initial delay",
+			retry:     ExponentialRetry{Initial: time.Second, Max: time.Minute, Multiplier: 2},
+			attempt:   0,
+			wantRetry: true,
+			wantDelay: time.Second,
+		},
+		{
+			name:      "delay doubles with each attempt",
+			retry:     ExponentialRetry{Initial: time.Second, Max: time.Minute, Multiplier: 2},
+			attempt:   2,
+			wantRetry: true,
+			wantDelay: 4 * time.Second,
+		},
+		{
+			name:      "delay is capped at Max",
+			retry:     ExponentialRetry{Initial: time.Second, Max: 5 * time.Second, Multiplier: 2},
+			attempt:   10,
+			wantRetry: true,
+			wantDelay: 5 * time.Second,
+		},
+		{
+			name:      "zero Multiplier defaults to 2",
+			retry:     ExponentialRetry{Initial: time.Second, Max: time.Minute},
+			attempt:   1,
+			wantRetry: true,
+			wantDelay: 2 * time.Second,
+		},
+		{
+			name:      "stops once MaxAttempts is reached",
+			retry:     ExponentialRetry{Initial: time.Second, Max: time.Minute, Multiplier: 2, MaxAttempts: 3},
+			attempt:   3,
+			wantRetry: false,
+			wantDelay: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delay, retry := tt.retry.Next(tt.attempt)
+			if retry != tt.wantRetry {
+				t.Errorf("ExponentialRetry.Next(%d) retry = %v, want %v", tt.attempt, retry, tt.wantRetry)
+			}
+			if retry && delay != tt.wantDelay {
+				t.Errorf("ExponentialRetry.Next(%d) delay = %v, want %v", tt.attempt, delay, tt.wantDelay)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package jobs
+
+import (
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// scheduleParser принимает как дескрипторы вида "@every 5m", "@hourly", так и
+// стандартные 5-полевые cron-выражения (минута час день-месяца месяц день-недели).
+var scheduleParser = cron.NewParser(
+	cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor,
+)
+
+// Schedule оборачивает разобранное cron-выражение, определяющее, когда job должен
+// запускаться повторно.
+type Schedule struct {
+	spec     string
+	schedule cron.Schedule
+}
+
+// ParseSchedule разбирает spec в Schedule. Поддерживаются как "@every 5m"/"@hourly",
+// так и стандартные 5-полевые cron-выражения.
+func ParseSchedule(spec string) (*Schedule, error) {
+	schedule, err := scheduleParser.Parse(spec)
+	if err != nil {
+		return nil, err
+	}
+	return &Schedule{spec: spec, schedule: schedule}, nil
+}
+
+// Next возвращает ближайший момент запуска после t.
+func (s *Schedule) Next(t time.Time) time.Time {
+	return s.schedule.Next(t)
+}
+
+// String возвращает исходное выражение расписания.
+func (s *Schedule) String() string {
+	return s.spec
+}
@@ -0,0 +1,256 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/Gen-Do/skeleton-service/internal/pkg/logger"
+)
+
+// tickInterval — как часто Scheduler проверяет зарегистрированные записи на
+// готовность к запуску. Разрешение расписаний не точнее этого интервала.
+const tickInterval = time.Second
+
+// entry — одна запись расписания: периодическая (schedule != nil) или одноразовая
+// отложенная (runAt, выполняется один раз и помечается fired).
+type entry struct {
+	jobName     string
+	schedule    *Schedule
+	runAt       time.Time
+	nextRun     time.Time
+	fired       bool
+	payload     Payload
+	retry       RetryPolicy
+	concurrency int
+	sem         chan struct{}
+}
+
+// Scheduler запускает зарегистрированные Job по cron-расписанию или один раз в
+// заданное время, с повторами по RetryPolicy и ограничением конкурентности на job,
+// сохраняя результат каждого прогона в Store.
+type Scheduler struct {
+	logger logger.Logger
+	tracer trace.Tracer
+	store  Store
+
+	mu      sync.Mutex
+	entries []*entry
+}
+
+// NewScheduler создает Scheduler, сохраняющий состояние прогонов в store.
+func NewScheduler(log logger.Logger, tracer trace.Tracer, store Store) *Scheduler {
+	return &Scheduler{
+		logger: log,
+		tracer: tracer,
+		store:  store,
+	}
+}
+
+// Every ставит job с именем jobName на повторяющееся расписание cronSpec
+// ("@every 5m" или стандартное 5-полевое cron-выражение), ограничивая число
+// одновременных выполнений concurrency (0 или отрицательное значение трактуется как 1).
+func (s *Scheduler) Every(jobName, cronSpec string, payload Payload, retry RetryPolicy, concurrency int) error {
+	schedule, err := ParseSchedule(cronSpec)
+	if err != nil {
+		return fmt.Errorf("jobs: parse schedule %q for job %q: %w", cronSpec, jobName, err)
+	}
+
+	s.add(&entry{
+		jobName:     jobName,
+		schedule:    schedule,
+		nextRun:     schedule.Next(s.now()),
+		payload:     payload,
+		retry:       retry,
+		concurrency: normalizeConcurrency(concurrency),
+	})
+	return nil
+}
+
+// At ставит job с именем jobName на одноразовый запуск в момент runAt.
+func (s *Scheduler) At(jobName string, runAt time.Time, payload Payload, retry RetryPolicy) error {
+	s.add(&entry{
+		jobName:     jobName,
+		runAt:       runAt,
+		nextRun:     runAt,
+		payload:     payload,
+		retry:       retry,
+		concurrency: 1,
+	})
+	return nil
+}
+
+func (s *Scheduler) add(e *entry) {
+	e.sem = make(chan struct{}, e.concurrency)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = append(s.entries, e)
+}
+
+// Run блокируется и каждый tickInterval запускает все записи, чье время настало,
+// пока не будет отменен ctx.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(tickInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.tick(ctx, now)
+		}
+	}
+}
+
+// tick запускает все due-записи, не блокируясь на тех, у кого исчерпан лимит
+// конкурентности: такой запуск пропускается, а запись все равно продвигается на
+// следующий момент по расписанию (одноразовая помечается выполненной).
+func (s *Scheduler) tick(ctx context.Context, now time.Time) {
+	s.mu.Lock()
+	due := make([]*entry, 0)
+	remaining := s.entries[:0]
+	for _, e := range s.entries {
+		if e.schedule == nil && e.fired {
+			continue // одноразовая запись уже выполнена — не сохраняем ее дальше
+		}
+		if !now.Before(e.nextRun) {
+			due = append(due, e)
+		}
+		remaining = append(remaining, e)
+	}
+	s.entries = remaining
+	s.mu.Unlock()
+
+	for _, e := range due {
+		s.scheduleNext(e, now)
+
+		select {
+		case e.sem <- struct{}{}:
+			go func(e *entry) {
+				defer func() { <-e.sem }()
+				s.execute(ctx, e.jobName, e.payload, e.retry)
+			}(e)
+		default:
+			s.logger.Warn(ctx, "Job skipped tick: concurrency limit reached", logger.F("job", e.jobName))
+		}
+	}
+}
+
+// scheduleNext продвигает периодическую запись на следующий запуск или помечает
+// одноразовую как выполненную.
+func (s *Scheduler) scheduleNext(e *entry, now time.Time) {
+	if e.schedule != nil {
+		e.nextRun = e.schedule.Next(now)
+		return
+	}
+	e.fired = true
+}
+
+// Trigger немедленно запускает job с именем jobName в обход расписания — используется
+// admin-эндпоинтом /jobs для ручного запуска.
+func (s *Scheduler) Trigger(ctx context.Context, jobName string, payload Payload) error {
+	if _, ok := lookup(jobName); !ok {
+		return fmt.Errorf("jobs: job %q is not registered", jobName)
+	}
+	// execute запускается в отдельной горутине и обязан пережить возврат из Trigger —
+	// ctx же обычно приходит из HTTP-запроса и отменяется сразу, как только обработчик
+	// вернет ответ. detach сохраняет trace-контекст, но не наследует отмену.
+	go s.execute(detach(ctx), jobName, payload, NoRetry{})
+	return nil
+}
+
+// execute выполняет job с именем jobName, повторяя по retry при ошибке, оборачивая
+// каждую попытку спаном трассировки и сохраняя итог в Store.
+func (s *Scheduler) execute(ctx context.Context, jobName string, payload Payload, retry RetryPolicy) {
+	job, ok := lookup(jobName)
+	if !ok {
+		s.logger.Error(ctx, "Job not found in registry", logger.F("job", jobName))
+		return
+	}
+	if retry == nil {
+		retry = NoRetry{}
+	}
+
+	attempt := 0
+	for {
+		record := s.runOnce(ctx, job, payload, attempt)
+		if s.store != nil {
+			if err := s.store.SaveRun(ctx, record); err != nil {
+				s.logger.Error(ctx, "Failed to persist job run", logger.F("job", jobName), logger.F("error", err))
+			}
+		}
+
+		if record.Status == StatusSucceeded {
+			return
+		}
+
+		delay, shouldRetry := retry.Next(attempt)
+		if !shouldRetry {
+			return
+		}
+		attempt++
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// runOnce выполняет ровно одну попытку job внутри спана трассировки и возвращает
+// итоговый RunRecord.
+func (s *Scheduler) runOnce(ctx context.Context, job Job, payload Payload, attempt int) RunRecord {
+	ctx, span := s.tracer.Start(ctx, "jobs.job/"+job.Name())
+	defer span.End()
+
+	start := time.Now()
+	s.logger.Info(ctx, "Job run starting", logger.F("job", job.Name()), logger.F("attempt", attempt))
+
+	err := job.Run(ctx, payload)
+
+	record := RunRecord{
+		JobName:    job.Name(),
+		Attempt:    attempt,
+		StartedAt:  start,
+		FinishedAt: time.Now(),
+	}
+
+	if err != nil {
+		record.Status = StatusFailed
+		record.Error = err.Error()
+		s.logger.Error(ctx, "Job run failed", logger.F("job", job.Name()), logger.F("attempt", attempt), logger.F("error", err))
+		return record
+	}
+
+	record.Status = StatusSucceeded
+	s.logger.Info(ctx, "Job run succeeded", logger.F("job", job.Name()), logger.F("attempt", attempt))
+	return record
+}
+
+// now возвращает текущее время; вынесено в метод, чтобы при необходимости его
+// можно было подменить в тестах.
+func (s *Scheduler) now() time.Time {
+	return time.Now()
+}
+
+func normalizeConcurrency(concurrency int) int {
+	if concurrency <= 0 {
+		return 1
+	}
+	return concurrency
+}
+
+// detach возвращает context.Background(), carrying over ctx's trace span so spans
+// started against the result still link to the caller's trace, but without
+// inheriting ctx's cancellation — for work that must outlive the request that
+// triggered it.
+func detach(ctx context.Context) context.Context {
+	return trace.ContextWithSpanContext(context.Background(), trace.SpanContextFromContext(ctx))
+}
@@ -0,0 +1,96 @@
+package jobs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel"
+
+	"github.com/Gen-Do/skeleton-service/internal/pkg/logger"
+)
+
+// noopLogger discards everything — enough to satisfy logger.Logger in tests that
+// don't assert on log output.
+type noopLogger struct{}
+
+func (noopLogger) Debug(context.Context, string, ...logger.Field) {}
+func (noopLogger) Info(context.Context, string, ...logger.Field)  {}
+func (noopLogger) Warn(context.Context, string, ...logger.Field)  {}
+func (noopLogger) Error(context.Context, string, ...logger.Field) {}
+
+// tickJob records how many times it ran via a buffered channel.
+type tickJob struct {
+	name string
+	ran  chan struct{}
+}
+
+func (j *tickJob) Name() string { return j.name }
+
+func (j *tickJob) Run(context.Context, Payload) error {
+	j.ran <- struct{}{}
+	return nil
+}
+
+func waitRan(t *testing.T, ran chan struct{}) {
+	t.Helper()
+	select {
+	case <-ran:
+	case <-time.After(time.Second):
+		t.Fatal("job did not run within timeout")
+	}
+}
+
+func assertDidNotRun(t *testing.T, ran chan struct{}) {
+	t.Helper()
+	select {
+	case <-ran:
+		t.Fatal("job ran but was not expected to")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+// TestSchedulerTickPeriodicVsOneShot verifies tick's two cases: a periodic entry
+// (Every) survives a due run and reschedules, while a one-shot entry (At) runs once
+// and is dropped from the schedule on the following tick.
+func TestSchedulerTickPeriodicVsOneShot(t *testing.T) {
+	periodicJob := &tickJob{name: "tick-test-periodic", ran: make(chan struct{}, 4)}
+	oneShotJob := &tickJob{name: "tick-test-oneshot", ran: make(chan struct{}, 4)}
+	Register(periodicJob)
+	Register(oneShotJob)
+
+	s := NewScheduler(noopLogger{}, otel.Tracer("test"), nil)
+
+	now := time.Now()
+	if err := s.Every(periodicJob.name, "@every 1h", Payload{}, NoRetry{}, 1); err != nil {
+		t.Fatalf("Every() error = %v", err)
+	}
+	if err := s.At(oneShotJob.name, now, Payload{}, NoRetry{}); err != nil {
+		t.Fatalf("At() error = %v", err)
+	}
+
+	// First tick: both entries are due.
+	s.tick(context.Background(), now.Add(2*time.Hour))
+	waitRan(t, periodicJob.ran)
+	waitRan(t, oneShotJob.ran)
+
+	s.mu.Lock()
+	remainingAfterFirstTick := len(s.entries)
+	s.mu.Unlock()
+	if remainingAfterFirstTick != 2 {
+		t.Fatalf("entries after first tick = %d, want 2 (one-shot stays until the next tick drops it)", remainingAfterFirstTick)
+	}
+
+	// Second tick, still past the periodic entry's rescheduled nextRun: the periodic
+	// entry runs again, the already-fired one-shot entry is dropped and does not run.
+	s.tick(context.Background(), now.Add(4*time.Hour))
+	waitRan(t, periodicJob.ran)
+	assertDidNotRun(t, oneShotJob.ran)
+
+	s.mu.Lock()
+	remainingAfterSecondTick := len(s.entries)
+	s.mu.Unlock()
+	if remainingAfterSecondTick != 1 {
+		t.Fatalf("entries after second tick = %d, want 1 (only the periodic entry left)", remainingAfterSecondTick)
+	}
+}
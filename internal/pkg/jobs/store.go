@@ -0,0 +1,64 @@
+package jobs
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RunStatus описывает исход одного выполнения job.
+type RunStatus string
+
+const (
+	StatusRunning   RunStatus = "running"
+	StatusSucceeded RunStatus = "succeeded"
+	StatusFailed    RunStatus = "failed"
+)
+
+// RunRecord — состояние одного выполнения job, которое переживает рестарт процесса,
+// если Store реализован поверх внешнего хранилища.
+type RunRecord struct {
+	JobName    string
+	Attempt    int
+	Status     RunStatus
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Error      string
+}
+
+// Store хранит последний прогон каждого job'а. Реализация по умолчанию — in-memory
+// и не переживает рестарт; NewSQLStore (сборочный тег "sql") хранит его в таблице
+// job_runs, где он переживает рестарт. Redis-бэкенда нет. Scheduler только пишет в
+// Store через SaveRun; LastRun читает admin-эндпоинт /jobs, а записи расписания в
+// Store не сохраняются и после рестарта не восстанавливаются.
+type Store interface {
+	SaveRun(ctx context.Context, record RunRecord) error
+	LastRun(ctx context.Context, jobName string) (RunRecord, bool, error)
+}
+
+// memoryStore — Store по умолчанию: хранит последний прогон каждого job'а в памяти.
+type memoryStore struct {
+	mu   sync.RWMutex
+	last map[string]RunRecord
+}
+
+// NewMemoryStore создает Store, хранящий состояние только в памяти процесса.
+func NewMemoryStore() Store {
+	return &memoryStore{last: make(map[string]RunRecord)}
+}
+
+// SaveRun сохраняет record как последний известный прогон record.JobName.
+func (s *memoryStore) SaveRun(_ context.Context, record RunRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.last[record.JobName] = record
+	return nil
+}
+
+// LastRun возвращает последний сохраненный прогон jobName, если он есть.
+func (s *memoryStore) LastRun(_ context.Context, jobName string) (RunRecord, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	record, ok := s.last[jobName]
+	return record, ok, nil
+}
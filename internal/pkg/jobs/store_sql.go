@@ -0,0 +1,54 @@
+//go:build sql
+
+package jobs
+
+import (
+	"context"
+	"database/sql"
+)
+
+// sqlStore сохраняет прогоны job'ов в таблице job_runs, так что последний статус
+// переживает рестарт процесса. Подключается сборочным тегом "sql", чтобы driver
+// БД не тянулся в сборки, которым хранилище прогонов не нужно.
+type sqlStore struct {
+	db *sql.DB
+}
+
+// NewSQLStore создает Store поверх таблицы job_runs в db (Postgres или совместимой
+// БД). Таблица должна существовать заранее — миграция не входит в зону
+// ответственности этого пакета.
+func NewSQLStore(db *sql.DB) Store {
+	return &sqlStore{db: db}
+}
+
+// SaveRun вставляет или обновляет последний прогон record.JobName.
+func (s *sqlStore) SaveRun(ctx context.Context, record RunRecord) error {
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO job_runs (job_name, attempt, status, started_at, finished_at, error)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (job_name) DO UPDATE SET
+			attempt = excluded.attempt,
+			status = excluded.status,
+			started_at = excluded.started_at,
+			finished_at = excluded.finished_at,
+			error = excluded.error
+	`, record.JobName, record.Attempt, record.Status, record.StartedAt, record.FinishedAt, record.Error)
+	return err
+}
+
+// LastRun читает последний сохраненный прогон jobName из job_runs.
+func (s *sqlStore) LastRun(ctx context.Context, jobName string) (RunRecord, bool, error) {
+	var record RunRecord
+	row := s.db.QueryRowContext(ctx, `
+		SELECT job_name, attempt, status, started_at, finished_at, error
+		FROM job_runs WHERE job_name = $1
+	`, jobName)
+
+	if err := row.Scan(&record.JobName, &record.Attempt, &record.Status, &record.StartedAt, &record.FinishedAt, &record.Error); err != nil {
+		if err == sql.ErrNoRows {
+			return RunRecord{}, false, nil
+		}
+		return RunRecord{}, false, err
+	}
+	return record, true, nil
+}
@@ -1,67 +1,76 @@
 package logger
 
 import (
-	"time"
+	"context"
 
 	"github.com/Gen-Do/skeleton-service/internal/pkg/env"
-	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// Field представляет одно поле структурированного лога. Бэкенды сами решают,
+// как сериализовать Value (logrus.Fields, slog.Attr и т.д.).
+type Field struct {
+	Key   string
+	Value interface{}
+}
+
+// F — короткий конструктор Field для вызовов вида logger.F("user_id", id).
+func F(key string, value interface{}) Field {
+	return Field{Key: key, Value: value}
+}
+
+// Logger — интерфейс структурированного логирования, не привязанный к конкретному
+// бэкенду. internal-пакеты (server, metrics, example) должны зависеть только от
+// этого интерфейса, а не от logrus или slog напрямую.
+type Logger interface {
+	Debug(ctx context.Context, msg string, fields ...Field)
+	Info(ctx context.Context, msg string, fields ...Field)
+	Warn(ctx context.Context, msg string, fields ...Field)
+	Error(ctx context.Context, msg string, fields ...Field)
+}
+
 // Config содержит настройки для логгера
 type Config struct {
-	Level  string
-	Format string // "json" или "text"
+	Level   string
+	Format  string // "json" или "text"
+	Backend string // "logrus" или "slog"
 }
 
 // DefaultConfig возвращает конфигурацию логгера по умолчанию
 func DefaultConfig() *Config {
 	return &Config{
-		Level:  env.GetString("LOG_LEVEL", "info"),
-		Format: env.GetString("LOG_FORMAT", "json"),
+		Level:   env.GetString("LOG_LEVEL", "info"),
+		Format:  env.GetString("LOG_FORMAT", "json"),
+		Backend: env.GetString("LOG_BACKEND", "logrus"),
 	}
 }
 
-// Setup настраивает и возвращает настроенный логгер
-func Setup(config *Config) *logrus.Logger {
-	logger := logrus.New()
-
-	// Настройка форматтера
-	if config.Format == "json" {
-		logger.SetFormatter(&logrus.JSONFormatter{
-			TimestampFormat: time.RFC3339,
-		})
-	} else {
-		logger.SetFormatter(&logrus.TextFormatter{
-			FullTimestamp:   true,
-			TimestampFormat: time.RFC3339,
-		})
+// Setup настраивает и возвращает Logger с бэкендом, выбранным по Config.Backend
+// ("logrus" или "slog"). Неизвестный бэкенд молча откатывается на logrus.
+func Setup(config *Config) Logger {
+	switch config.Backend {
+	case "slog":
+		return newSlogLogger(config)
+	default:
+		return newLogrusLogger(config)
 	}
-
-	// Настройка уровня логирования
-	level, err := logrus.ParseLevel(config.Level)
-	if err != nil {
-		logger.WithError(err).Warn("Invalid log level, using info")
-		level = logrus.InfoLevel
-	}
-	logger.SetLevel(level)
-
-	return logger
 }
 
 // SetupDefault настраивает логгер с конфигурацией по умолчанию
-func SetupDefault() *logrus.Logger {
+func SetupDefault() Logger {
 	return Setup(DefaultConfig())
 }
 
-// WithFields создает новый логгер с дополнительными полями
-func WithFields(logger *logrus.Logger, fields logrus.Fields) *logrus.Entry {
-	return logger.WithFields(fields)
-}
-
-// WithServiceContext добавляет контекст сервиса к логгеру
-func WithServiceContext(logger *logrus.Logger, serviceName, version string) *logrus.Entry {
-	return logger.WithFields(logrus.Fields{
-		"service": serviceName,
-		"version": version,
-	})
+// traceFields извлекает trace_id/span_id из текущего OTel-спана в контексте, чтобы
+// каждая строка лога внутри запроса несла correlation ID независимо от бэкенда.
+// Если в контексте нет валидного спана, возвращает пустой срез.
+func traceFields(ctx context.Context) []Field {
+	spanCtx := trace.SpanContextFromContext(ctx)
+	if !spanCtx.IsValid() {
+		return nil
+	}
+	return []Field{
+		F("trace_id", spanCtx.TraceID().String()),
+		F("span_id", spanCtx.SpanID().String()),
+	}
 }
@@ -0,0 +1,63 @@
+package logger
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// logrusLogger — бэкенд Logger на основе logrus.
+type logrusLogger struct {
+	logger *logrus.Logger
+}
+
+// newLogrusLogger настраивает logrus.Logger согласно Config и оборачивает его в Logger.
+func newLogrusLogger(config *Config) *logrusLogger {
+	l := logrus.New()
+
+	if config.Format == "json" {
+		l.SetFormatter(&logrus.JSONFormatter{
+			TimestampFormat: time.RFC3339,
+		})
+	} else {
+		l.SetFormatter(&logrus.TextFormatter{
+			FullTimestamp:   true,
+			TimestampFormat: time.RFC3339,
+		})
+	}
+
+	level, err := logrus.ParseLevel(config.Level)
+	if err != nil {
+		l.WithError(err).Warn("Invalid log level, using info")
+		level = logrus.InfoLevel
+	}
+	l.SetLevel(level)
+
+	return &logrusLogger{logger: l}
+}
+
+func (l *logrusLogger) entry(ctx context.Context, fields []Field) *logrus.Entry {
+	all := append(append([]Field{}, traceFields(ctx)...), fields...)
+	logrusFields := make(logrus.Fields, len(all))
+	for _, f := range all {
+		logrusFields[f.Key] = f.Value
+	}
+	return l.logger.WithFields(logrusFields)
+}
+
+func (l *logrusLogger) Debug(ctx context.Context, msg string, fields ...Field) {
+	l.entry(ctx, fields).Debug(msg)
+}
+
+func (l *logrusLogger) Info(ctx context.Context, msg string, fields ...Field) {
+	l.entry(ctx, fields).Info(msg)
+}
+
+func (l *logrusLogger) Warn(ctx context.Context, msg string, fields ...Field) {
+	l.entry(ctx, fields).Warn(msg)
+}
+
+func (l *logrusLogger) Error(ctx context.Context, msg string, fields ...Field) {
+	l.entry(ctx, fields).Error(msg)
+}
@@ -0,0 +1,59 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"os"
+)
+
+// slogLogger — бэкенд Logger на основе стандартного log/slog (Go 1.21+).
+type slogLogger struct {
+	logger *slog.Logger
+}
+
+// newSlogLogger настраивает slog.Logger согласно Config и оборачивает его в Logger.
+func newSlogLogger(config *Config) *slogLogger {
+	opts := &slog.HandlerOptions{Level: parseSlogLevel(config.Level)}
+
+	var handler slog.Handler
+	if config.Format == "text" {
+		handler = slog.NewTextHandler(os.Stdout, opts)
+	} else {
+		handler = slog.NewJSONHandler(os.Stdout, opts)
+	}
+
+	return &slogLogger{logger: slog.New(handler)}
+}
+
+func parseSlogLevel(level string) slog.Level {
+	var l slog.Level
+	if err := l.UnmarshalText([]byte(level)); err != nil {
+		return slog.LevelInfo
+	}
+	return l
+}
+
+func toSlogArgs(ctx context.Context, fields []Field) []any {
+	all := append(append([]Field{}, traceFields(ctx)...), fields...)
+	args := make([]any, 0, len(all)*2)
+	for _, f := range all {
+		args = append(args, f.Key, f.Value)
+	}
+	return args
+}
+
+func (l *slogLogger) Debug(ctx context.Context, msg string, fields ...Field) {
+	l.logger.DebugContext(ctx, msg, toSlogArgs(ctx, fields)...)
+}
+
+func (l *slogLogger) Info(ctx context.Context, msg string, fields ...Field) {
+	l.logger.InfoContext(ctx, msg, toSlogArgs(ctx, fields)...)
+}
+
+func (l *slogLogger) Warn(ctx context.Context, msg string, fields ...Field) {
+	l.logger.WarnContext(ctx, msg, toSlogArgs(ctx, fields)...)
+}
+
+func (l *slogLogger) Error(ctx context.Context, msg string, fields ...Field) {
+	l.logger.ErrorContext(ctx, msg, toSlogArgs(ctx, fields)...)
+}
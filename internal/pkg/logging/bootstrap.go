@@ -0,0 +1,32 @@
+package logging
+
+import (
+	"github.com/Gen-Do/skeleton-service/internal/config"
+	"github.com/Gen-Do/skeleton-service/internal/pkg/env"
+	"github.com/Gen-Do/skeleton-service/internal/pkg/logger"
+)
+
+// FromServerConfig выбирает формат вывода по cfg.Server.Environment: "production"
+// получает компактный JSON, любое другое окружение (local/staging/dev) — текстовый
+// вывод для удобства чтения в терминале. Уровень и бэкенд берутся из cfg.Logging и
+// переменных окружения соответственно.
+func FromServerConfig(cfg *config.Config) *logger.Config {
+	format := "text"
+	if cfg.Server.Environment == "production" {
+		format = "json"
+	}
+
+	return &logger.Config{
+		Level:   cfg.Logging.Level,
+		Format:  format,
+		Backend: env.GetString("LOG_BACKEND", "logrus"),
+	}
+}
+
+// Bootstrap строит Logger из cfg по тем же правилам, что и FromServerConfig, и
+// делает его логгером по умолчанию для From(ctx) на контекстах без явной привязки.
+func Bootstrap(cfg *config.Config) Logger {
+	l := logger.Setup(FromServerConfig(cfg))
+	SetDefault(l)
+	return l
+}
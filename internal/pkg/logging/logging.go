@@ -0,0 +1,94 @@
+// Package logging добавляет контекстную привязку дочерних логгеров поверх
+// internal/pkg/logger: Logger/Field/F переиспользуют logger как есть (логика
+// форматирования и trace_id/span_id уже живет там), а logging.From/With позволяют
+// проносить логгер с накопленными полями через context.Context, не передавая его
+// явным параметром через всю цепочку вызовов.
+package logging
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/Gen-Do/skeleton-service/internal/pkg/logger"
+)
+
+// Logger — алиас на logger.Logger: это один и тот же интерфейс, так что код,
+// мигрировавший на logging.Logger, остается совместим с Setup/SetupDefault.
+type Logger = logger.Logger
+
+// Field — алиас на logger.Field.
+type Field = logger.Field
+
+// F — короткий конструктор Field, см. logger.F.
+func F(key string, value interface{}) Field {
+	return logger.F(key, value)
+}
+
+type ctxKey struct{}
+
+// defaultLogger хранит логгер по умолчанию за atomic.Pointer, а не за sync.Mutex,
+// поскольку From читает его на каждый запрос без привязанного в ctx логгера —
+// нужна конкурентная читаемость без блокировок, а SetDefault вызывается редко
+// (один раз при старте, возможно повторно на config.Watch reload).
+var defaultLogger atomic.Pointer[Logger]
+
+// SetDefault заменяет логгер, который From возвращает для контекстов без явно
+// привязанного логгера. Вызывается один раз при старте приложения после того,
+// как известна конфигурация (см. FromServerConfig); если вызван не будет, From
+// лениво заведет логгер с настройками по умолчанию при первом обращении.
+func SetDefault(l Logger) {
+	defaultLogger.Store(&l)
+}
+
+// From возвращает логгер, привязанный к ctx через NewContext/With, либо логгер по
+// умолчанию, если ctx им не снабжен.
+func From(ctx context.Context) Logger {
+	if l, ok := ctx.Value(ctxKey{}).(Logger); ok {
+		return l
+	}
+	if p := defaultLogger.Load(); p != nil {
+		return *p
+	}
+	l := logger.SetupDefault()
+	defaultLogger.CompareAndSwap(nil, &l)
+	return *defaultLogger.Load()
+}
+
+// NewContext привязывает к ctx логгер l с дополнительными fields, которые будут
+// добавляться к каждому вызову From(ctx).Debug/Info/Warn/Error. Используется на
+// границе запроса/задачи, где логгер еще не взят из родительского ctx.
+func NewContext(ctx context.Context, l Logger, fields ...Field) context.Context {
+	return context.WithValue(ctx, ctxKey{}, &childLogger{base: l, fields: fields})
+}
+
+// With возвращает ctx с дочерним логгером, который проносит fields в дополнение к
+// тем, что уже накоплены в логгере, привязанном к ctx (или к логгеру по умолчанию).
+func With(ctx context.Context, fields ...Field) context.Context {
+	return NewContext(ctx, From(ctx), fields...)
+}
+
+// childLogger добавляет fields к каждому вызову base, реализуя Logger.
+type childLogger struct {
+	base   Logger
+	fields []Field
+}
+
+func (c *childLogger) merge(fields []Field) []Field {
+	return append(append([]Field{}, c.fields...), fields...)
+}
+
+func (c *childLogger) Debug(ctx context.Context, msg string, fields ...Field) {
+	c.base.Debug(ctx, msg, c.merge(fields)...)
+}
+
+func (c *childLogger) Info(ctx context.Context, msg string, fields ...Field) {
+	c.base.Info(ctx, msg, c.merge(fields)...)
+}
+
+func (c *childLogger) Warn(ctx context.Context, msg string, fields ...Field) {
+	c.base.Warn(ctx, msg, c.merge(fields)...)
+}
+
+func (c *childLogger) Error(ctx context.Context, msg string, fields ...Field) {
+	c.base.Error(ctx, msg, c.merge(fields)...)
+}
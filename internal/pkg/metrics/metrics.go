@@ -1,17 +1,30 @@
 package metrics
 
 import (
+	"context"
+
 	"github.com/Gen-Do/skeleton-service/internal/pkg/env"
 	"net/http"
 	"strconv"
 	"time"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/collectors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// unmatchedEndpoint используется в качестве значения лейбла endpoint,
+// когда запрос не был сопоставлен ни с одним зарегистрированным маршрутом
+// (например, 404) — это защищает метрики от неограниченной кардинальности
+// по сырым r.URL.Path.
+const unmatchedEndpoint = "unmatched"
+
+// sizeBuckets — бакеты по умолчанию для гистограмм размера тела запроса/ответа в байтах.
+var sizeBuckets = []float64{64, 256, 1024, 4096, 16384, 65536, 262144, 1048576}
+
 // Config содержит настройки для метрик
 type Config struct {
 	ServiceName string
@@ -34,9 +47,20 @@ type Metrics struct {
 	registry          *prometheus.Registry
 	httpRequestsTotal *prometheus.CounterVec
 	httpDuration      *prometheus.HistogramVec
+	httpRequestSize   *prometheus.HistogramVec
+	httpResponseSize  *prometheus.HistogramVec
 	httpInFlight      prometheus.Gauge
 }
 
+// InstrumentationMiddleware создает HTTP middleware, инструментирующее обработчики
+// метриками запросов. В отличие от Metrics.Middleware(), который на каждый запрос
+// определяет имя маршрута через chi.RouteContext, NewHandler позволяет заранее
+// привязать имя обработчика — это полезно при монтировании суб-роутеров или когда
+// паттерн маршрута ещё не известен в момент построения middleware-стека.
+type InstrumentationMiddleware interface {
+	NewHandler(handlerName string, next http.Handler) http.Handler
+}
+
 // New настраивает и возвращает Metrics
 func New() *Metrics {
 	config := defaultConfig()
@@ -54,7 +78,7 @@ func New() *Metrics {
 			Name:      "http_requests_total",
 			Help:      "Total number of HTTP requests",
 		},
-		[]string{"method", "endpoint", "status"},
+		[]string{"method", "endpoint", "status", "status_class"},
 	)
 
 	httpDuration := prometheus.NewHistogramVec(
@@ -64,9 +88,29 @@ func New() *Metrics {
 			Help:      "Duration of HTTP requests in seconds",
 			Buckets:   prometheus.DefBuckets,
 		},
+		[]string{"method", "endpoint", "status_class"},
+	)
+
+	httpRequestSize := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: config.Namespace,
+			Name:      "http_request_size_bytes",
+			Help:      "Size of HTTP request bodies in bytes",
+			Buckets:   sizeBuckets,
+		},
 		[]string{"method", "endpoint"},
 	)
 
+	httpResponseSize := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: config.Namespace,
+			Name:      "http_response_size_bytes",
+			Help:      "Size of HTTP response bodies in bytes",
+			Buckets:   sizeBuckets,
+		},
+		[]string{"method", "endpoint", "status_class"},
+	)
+
 	httpInFlight := prometheus.NewGauge(
 		prometheus.GaugeOpts{
 			Namespace: config.Namespace,
@@ -78,6 +122,8 @@ func New() *Metrics {
 	// Регистрируем метрики
 	registry.MustRegister(httpRequestsTotal)
 	registry.MustRegister(httpDuration)
+	registry.MustRegister(httpRequestSize)
+	registry.MustRegister(httpResponseSize)
 	registry.MustRegister(httpInFlight)
 
 	// Регистрируем стандартные метрики Go
@@ -89,6 +135,8 @@ func New() *Metrics {
 		registry:          registry,
 		httpRequestsTotal: httpRequestsTotal,
 		httpDuration:      httpDuration,
+		httpRequestSize:   httpRequestSize,
+		httpResponseSize:  httpResponseSize,
 		httpInFlight:      httpInFlight,
 	}
 }
@@ -117,10 +165,16 @@ func (m *Metrics) Handler() http.Handler {
 			w.WriteHeader(http.StatusNotFound)
 		})
 	}
-	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+	// EnableOpenMetrics выставляет формат OpenMetrics, который умеет переносить
+	// exemplars — так Grafana может прыгнуть от всплеска latency прямо к трейсу.
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{EnableOpenMetrics: true})
 }
 
-// Middleware возвращает middleware для сбора HTTP метрик
+// Middleware возвращает middleware для сбора HTTP метрик. Имя маршрута (лейбл endpoint)
+// определяется на основе сопоставленного chi route pattern
+// (chi.RouteContext(r.Context()).RoutePattern()), а не сырого r.URL.Path, что исключает
+// неограниченную кардинальность на маршрутах с параметрами (например, /users/{id}).
+// Для запросов, не сопоставленных ни с одним маршрутом (404), используется "unmatched".
 func (m *Metrics) Middleware() func(next http.Handler) http.Handler {
 	if !m.config.Enabled {
 		// Возвращаем no-op middleware, если метрики отключены
@@ -130,27 +184,109 @@ func (m *Metrics) Middleware() func(next http.Handler) http.Handler {
 	}
 
 	return func(next http.Handler) http.Handler {
-		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			start := time.Now()
+		return m.instrument(routePattern, next)
+	}
+}
+
+// NewHandler реализует InstrumentationMiddleware: оборачивает next метриками с заранее
+// заданным именем обработчика вместо определения паттерна маршрута в рантайме.
+func (m *Metrics) NewHandler(handlerName string, next http.Handler) http.Handler {
+	if !m.config.Enabled {
+		return next
+	}
+	return m.instrument(func(*http.Request) string { return handlerName }, next)
+}
 
-			// Увеличиваем счетчик активных запросов
-			m.httpInFlight.Inc()
-			defer m.httpInFlight.Dec()
+// instrument — общая реализация инструментации запроса метриками, используемая и
+// Middleware (динамическое имя по chi route pattern), и NewHandler (статическое имя).
+// endpointFn вызывается после next.ServeHTTP, так как chi заполняет RoutePattern
+// только по мере прохождения дерева маршрутов.
+func (m *Metrics) instrument(endpointFn func(*http.Request) string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		// Увеличиваем счетчик активных запросов
+		m.httpInFlight.Inc()
+		defer m.httpInFlight.Dec()
+
+		// Создаем wrapped response writer для получения статус кода
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+		// Обрабатываем запрос
+		next.ServeHTTP(ww, r)
+
+		// Записываем метрики
+		duration := time.Since(start).Seconds()
+		status := ww.Status()
+		class := statusClass(status)
+		endpoint := endpointFn(r)
+		exemplar := exemplarLabels(r.Context())
+
+		if r.ContentLength > 0 {
+			m.httpRequestSize.WithLabelValues(r.Method, endpoint).Observe(float64(r.ContentLength))
+		}
 
-			// Создаем wrapped response writer для получения статус кода
-			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		m.httpRequestsTotal.WithLabelValues(r.Method, endpoint, strconv.Itoa(status), class).Inc()
+		observeWithExemplar(m.httpDuration.WithLabelValues(r.Method, endpoint, class), duration, exemplar)
+		observeWithExemplar(m.httpResponseSize.WithLabelValues(r.Method, endpoint, class), float64(ww.BytesWritten()), exemplar)
+	})
+}
 
-			// Обрабатываем запрос
-			next.ServeHTTP(ww, r)
+// exemplarLabels извлекает trace_id/span_id текущего OTel-спана для использования
+// в качестве Prometheus exemplar. Возвращает nil, если в контексте запроса нет
+// валидного спана (например, трассировка выключена).
+func exemplarLabels(ctx context.Context) prometheus.Labels {
+	spanCtx := trace.SpanContextFromContext(ctx)
+	if !spanCtx.IsValid() {
+		return nil
+	}
+	return prometheus.Labels{
+		"trace_id": spanCtx.TraceID().String(),
+		"span_id":  spanCtx.SpanID().String(),
+	}
+}
 
-			// Записываем метрики
-			duration := time.Since(start).Seconds()
-			status := strconv.Itoa(ww.Status())
-			endpoint := r.URL.Path
+// observeWithExemplar записывает наблюдение в гистограмму вместе с exemplar-лейблами,
+// если они заданы и наблюдатель поддерживает prometheus.ExemplarObserver, иначе
+// выполняет обычный Observe.
+func observeWithExemplar(obs prometheus.Observer, value float64, exemplar prometheus.Labels) {
+	if exemplar == nil {
+		obs.Observe(value)
+		return
+	}
+	if eo, ok := obs.(prometheus.ExemplarObserver); ok {
+		eo.ObserveWithExemplar(value, exemplar)
+		return
+	}
+	obs.Observe(value)
+}
 
-			m.httpRequestsTotal.WithLabelValues(r.Method, endpoint, status).Inc()
-			m.httpDuration.WithLabelValues(r.Method, endpoint).Observe(duration)
-		})
+// routePattern возвращает сопоставленный chi route pattern для запроса, либо
+// unmatchedEndpoint, если запрос не был сопоставлен ни с одним маршрутом.
+func routePattern(r *http.Request) string {
+	if rctx := chi.RouteContext(r.Context()); rctx != nil {
+		if pattern := rctx.RoutePattern(); pattern != "" {
+			return pattern
+		}
+	}
+	return unmatchedEndpoint
+}
+
+// statusClass сворачивает HTTP статус код в класс ("2xx", "4xx", "5xx" и т.д.).
+func statusClass(status int) string {
+	switch status / 100 {
+	case 1:
+		return "1xx"
+	case 2:
+		return "2xx"
+	case 3:
+		return "3xx"
+	case 4:
+		return "4xx"
+	case 5:
+		return "5xx"
+	default:
+		return "unknown"
 	}
 }
 
@@ -0,0 +1,56 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func TestStatusClass(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		expected string
+	}{
+		{name: "informational", status: 101, expected: "1xx"},
+		{name: "success", status: 200, expected: "2xx"},
+		{name: "redirect", status: 301, expected: "3xx"},
+		{name: "client error", status: 404, expected: "4xx"},
+		{name: "server error", status: 503, expected: "5xx"},
+		{name: "out of range", status: 999, expected: "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := statusClass(tt.status); got != tt.expected {
+				t.Errorf("statusClass(%d) = %v, want %v", tt.status, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRoutePattern(t *testing.T) {
+	t.Run("returns matched chi route pattern", func(t *testing.T) {
+		router := chi.NewRouter()
+		var got string
+		router.Get("/users/{id}", func(w http.ResponseWriter, r *http.Request) {
+			got = routePattern(r)
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/users/42", nil)
+		router.ServeHTTP(httptest.NewRecorder(), req)
+
+		if got != "/users/{id}" {
+			t.Errorf("routePattern() = %v, want /users/{id}", got)
+		}
+	})
+
+	t.Run("returns unmatched when request has no route context", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/anything", nil)
+		if got := routePattern(req); got != unmatchedEndpoint {
+			t.Errorf("routePattern() = %v, want %v", got, unmatchedEndpoint)
+		}
+	})
+}
@@ -0,0 +1,211 @@
+package periodic
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/Gen-Do/skeleton-service/internal/pkg/logger"
+	"github.com/Gen-Do/skeleton-service/internal/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Periodic описывает воркер, который периодически выполняет Run с заданным интервалом.
+type Periodic struct {
+	Name     string
+	Interval time.Duration
+	Jitter   time.Duration
+	Backoff  Backoff
+	Run      func(ctx context.Context) error
+}
+
+// Backoff задает политику экспоненциального отката для перезапуска упавшего воркера.
+// Нулевое значение равносильно DefaultBackoff().
+type Backoff struct {
+	Initial    time.Duration
+	Max        time.Duration
+	Multiplier float64
+}
+
+// DefaultBackoff возвращает backoff по умолчанию для воркеров, не задавших собственный.
+func DefaultBackoff() Backoff {
+	return Backoff{Initial: time.Second, Max: 30 * time.Second, Multiplier: 2}
+}
+
+// next возвращает задержку перед попыткой номер attempt (считая с нуля), не превышающую Max.
+func (b Backoff) next(attempt int) time.Duration {
+	if b.Initial <= 0 {
+		b = DefaultBackoff()
+	}
+	delay := float64(b.Initial)
+	for i := 0; i < attempt; i++ {
+		delay *= b.Multiplier
+	}
+	if b.Max > 0 && delay > float64(b.Max) {
+		delay = float64(b.Max)
+	}
+	return time.Duration(delay)
+}
+
+// WorkerRegistrar — аналог server.RouteRegistrar для воркеров: позволяет main.go
+// регистрировать воркеры так же декларативно, как маршруты через RegisterRoutes.
+type WorkerRegistrar interface {
+	RegisterWorkers(m *Manager)
+}
+
+// Manager управляет жизненным циклом набора Periodic воркеров: запускает их,
+// перезапускает упавшие с экспоненциальным откатом и останавливает все по отмене
+// контекста, ожидая завершения не дольше drainDeadline.
+type Manager struct {
+	logger        logger.Logger
+	drainDeadline time.Duration
+
+	runsTotal     *prometheus.CounterVec
+	runDuration   *prometheus.HistogramVec
+	failuresTotal *prometheus.CounterVec
+	up            *prometheus.GaugeVec
+
+	mu      sync.Mutex
+	workers []Periodic
+}
+
+// NewManager создает Manager и регистрирует его метрики в m, если m не nil.
+func NewManager(log logger.Logger, m *metrics.Metrics) *Manager {
+	mgr := &Manager{
+		logger:        log,
+		drainDeadline: 10 * time.Second,
+		runsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "service",
+			Name:      "worker_runs_total",
+			Help:      "Total number of worker run executions.",
+		}, []string{"worker"}),
+		runDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "service",
+			Name:      "worker_run_duration_seconds",
+			Help:      "Duration of worker run executions in seconds.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"worker"}),
+		failuresTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "service",
+			Name:      "worker_failures_total",
+			Help:      "Total number of failed worker run executions.",
+		}, []string{"worker"}),
+		up: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "service",
+			Name:      "worker_up",
+			Help:      "Whether the worker is currently running (1) or backing off/stopped (0).",
+		}, []string{"worker"}),
+	}
+
+	if m != nil {
+		_ = m.RegisterCollector(mgr.runsTotal)
+		_ = m.RegisterCollector(mgr.runDuration)
+		_ = m.RegisterCollector(mgr.failuresTotal)
+		_ = m.RegisterCollector(mgr.up)
+	}
+
+	return mgr
+}
+
+// Add регистрирует воркеры для последующего запуска через Run.
+func (m *Manager) Add(workers ...Periodic) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.workers = append(m.workers, workers...)
+}
+
+// RegisterWorkers добавляет воркеры, зарегистрированные через WorkerRegistrar, — так
+// main.go может подключать воркеры так же, как RouteRegistrar подключает маршруты.
+func (m *Manager) RegisterWorkers(registrars ...WorkerRegistrar) {
+	for _, r := range registrars {
+		r.RegisterWorkers(m)
+	}
+}
+
+// Run запускает все зарегистрированные воркеры и блокируется до отмены ctx, после
+// чего ждет их остановки не дольше drainDeadline.
+func (m *Manager) Run(ctx context.Context) {
+	m.mu.Lock()
+	workers := append([]Periodic{}, m.workers...)
+	m.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for _, w := range workers {
+		wg.Add(1)
+		go func(w Periodic) {
+			defer wg.Done()
+			m.supervise(ctx, w)
+		}(w)
+	}
+
+	<-ctx.Done()
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(m.drainDeadline):
+		m.logger.Warn(context.Background(), "Timed out waiting for workers to drain")
+	}
+}
+
+// supervise запускает w в цикле с заданным интервалом и джиттером, перезапуская его
+// с экспоненциальным откатом при ошибках, пока ctx не будет отменен.
+func (m *Manager) supervise(ctx context.Context, w Periodic) {
+	m.up.WithLabelValues(w.Name).Set(1)
+	defer m.up.WithLabelValues(w.Name).Set(0)
+
+	attempt := 0
+	for ctx.Err() == nil {
+		start := time.Now()
+		err := w.Run(ctx)
+		duration := time.Since(start).Seconds()
+
+		m.runsTotal.WithLabelValues(w.Name).Inc()
+		m.runDuration.WithLabelValues(w.Name).Observe(duration)
+
+		if err != nil && ctx.Err() == nil {
+			m.failuresTotal.WithLabelValues(w.Name).Inc()
+			delay := w.Backoff.next(attempt)
+			attempt++
+			m.logger.Error(ctx, "Worker run failed, backing off",
+				logger.F("worker", w.Name), logger.F("error", err), logger.F("backoff", delay.String()))
+			m.up.WithLabelValues(w.Name).Set(0)
+			if !sleep(ctx, delay) {
+				return
+			}
+			m.up.WithLabelValues(w.Name).Set(1)
+			continue
+		}
+
+		attempt = 0
+		if !sleep(ctx, withJitter(w.Interval, w.Jitter)) {
+			return
+		}
+	}
+}
+
+// withJitter добавляет к interval случайную прибавку в диапазоне [0, jitter).
+func withJitter(interval, jitter time.Duration) time.Duration {
+	if jitter <= 0 {
+		return interval
+	}
+	return interval + time.Duration(rand.Int63n(int64(jitter)))
+}
+
+// sleep ждет d или отмены ctx; возвращает false, если ctx был отменен раньше.
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,48 @@
+package periodic
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBackoffNext(t *testing.T) {
+	tests := []struct {
+		name    string
+		backoff Backoff
+		attempt int
+		want    time.Duration
+	}{
+		{
+			name:    "first attempt returns initial delay",
+			backoff: Backoff{Initial: time.Second, Max: 30 * time.Second, Multiplier: 2},
+			attempt: 0,
+			want:    time.Second,
+		},
+		{
+			name:    "delay doubles with each attempt",
+			backoff: Backoff{Initial: time.Second, Max: 30 * time.Second, Multiplier: 2},
+			attempt: 2,
+			want:    4 * time.Second,
+		},
+		{
+			name:    "delay is capped at Max",
+			backoff: Backoff{Initial: time.Second, Max: 5 * time.Second, Multiplier: 2},
+			attempt: 10,
+			want:    5 * time.Second,
+		},
+		{
+			name:    "zero value falls back to DefaultBackoff",
+			backoff: Backoff{},
+			attempt: 0,
+			want:    DefaultBackoff().Initial,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.backoff.next(tt.attempt); got != tt.want {
+				t.Errorf("Backoff.next(%d) = %v, want %v", tt.attempt, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"net/http"
+	"net/http/pprof"
+	"sync"
+
+	"github.com/Gen-Do/skeleton-service/internal/pkg/env"
+	"github.com/Gen-Do/skeleton-service/internal/pkg/logger"
+	"github.com/Gen-Do/skeleton-service/internal/pkg/metrics"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// Check — проверка готовности, зарегистрированная под именем (например, "db").
+// Возвращаемая ошибка делает соответствующую зависимость неготовой в /health/ready.
+type Check func(ctx context.Context) error
+
+// AdminConfig содержит настройки административного сервера
+type AdminConfig struct {
+	Port string
+}
+
+// defaultAdminConfig возвращает конфигурацию административного сервера по умолчанию
+func defaultAdminConfig() *AdminConfig {
+	return &AdminConfig{
+		Port: env.GetString("ADMIN_PORT", "9090"),
+	}
+}
+
+// AdminServer — отдельный HTTP-сервер для /metrics, pprof, /debug/vars и health-проверок,
+// развязанный с публичным сервером: он слушает на ADMIN_PORT, не делит с публичными
+// обработчиками ни таймауты, ни middleware, ни bind-адрес, и не должен быть выставлен наружу.
+type AdminServer struct {
+	config  *AdminConfig
+	router  *chi.Mux
+	server  *http.Server
+	logger  logger.Logger
+	metrics *metrics.Metrics
+
+	mu     sync.RWMutex
+	checks map[string]Check
+}
+
+// NewAdminServer создает и настраивает AdminServer.
+func NewAdminServer(metricsCollector *metrics.Metrics, log logger.Logger) *AdminServer {
+	config := defaultAdminConfig()
+	router := chi.NewRouter()
+
+	srv := &AdminServer{
+		config:  config,
+		router:  router,
+		logger:  log,
+		metrics: metricsCollector,
+		checks:  make(map[string]Check),
+	}
+	srv.server = &http.Server{
+		Addr:    ":" + config.Port,
+		Handler: router,
+	}
+
+	srv.setupRoutes()
+
+	return srv
+}
+
+// setupRoutes регистрирует обработчики /metrics, /debug/pprof, /debug/vars и health.
+func (a *AdminServer) setupRoutes() {
+	if a.metrics != nil {
+		a.router.Handle("/metrics", a.metrics.Handler())
+	}
+
+	// pprof.Index также обслуживает именованные профили (goroutine, heap, ...) по
+	// остатку пути, поэтому достаточно одного catch-all маршрута; cmdline/profile/
+	// symbol/trace обрабатываются отдельными хендлерами пакета net/http/pprof.
+	a.router.HandleFunc("/debug/pprof/*", pprof.Index)
+	a.router.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	a.router.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	a.router.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	a.router.HandleFunc("/debug/pprof/trace", pprof.Trace)
+
+	a.router.Handle("/debug/vars", expvar.Handler())
+
+	a.router.Get("/health/live", a.handleLive)
+	a.router.Get("/health/ready", a.handleReady)
+}
+
+// RegisterRoutes монтирует registrar на административный роутер — так на AdminServer
+// можно навесить дополнительные ops-эндпоинты (например, jobs.AdminHandler для
+// /jobs), не выставляя их на публичном Server.
+func (a *AdminServer) RegisterRoutes(registrar RouteRegistrar) {
+	registrar.RegisterRoutes(a.router)
+}
+
+// AddReadinessCheck регистрирует проверку готовности под именем name. Любая ошибка,
+// возвращенная fn, переводит /health/ready в 503 с указанием упавшей зависимости.
+func (a *AdminServer) AddReadinessCheck(name string, fn Check) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.checks[name] = fn
+}
+
+// handleLive сообщает, что процесс жив — без обращения к внешним зависимостям.
+func (a *AdminServer) handleLive(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(map[string]string{"status": "ok"})
+}
+
+// handleReady прогоняет все зарегистрированные Check и возвращает 503 с JSON-списком
+// упавших зависимостей, если хотя бы одна из них не готова.
+func (a *AdminServer) handleReady(w http.ResponseWriter, r *http.Request) {
+	a.mu.RLock()
+	checks := make(map[string]Check, len(a.checks))
+	for name, fn := range a.checks {
+		checks[name] = fn
+	}
+	a.mu.RUnlock()
+
+	failures := make(map[string]string)
+	for name, fn := range checks {
+		if err := fn(r.Context()); err != nil {
+			failures[name] = err.Error()
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if len(failures) > 0 {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"status": "unavailable",
+			"checks": failures,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(map[string]string{"status": "ok"})
+}
+
+// Start запускает административный сервер
+func (a *AdminServer) Start() error {
+	a.logger.Info(context.Background(), "Starting admin server", logger.F("port", a.config.Port))
+	return a.server.ListenAndServe()
+}
+
+// StartAsync запускает административный сервер асинхронно
+func (a *AdminServer) StartAsync() {
+	go func() {
+		if err := a.Start(); err != nil && err != http.ErrServerClosed {
+			a.logger.Error(context.Background(), "Failed to start admin server", logger.F("error", err))
+		}
+	}()
+}
+
+// Shutdown корректно останавливает административный сервер
+func (a *AdminServer) Shutdown(ctx context.Context) error {
+	a.logger.Info(ctx, "Shutting down admin server...")
+	return a.server.Shutdown(ctx)
+}
@@ -0,0 +1,34 @@
+package server
+
+import "net/http"
+
+// Decorator оборачивает http.Handler дополнительным поведением. Сигнатура совпадает
+// с тем, что ожидает chi.Router.Use, поэтому Pipeline можно передавать туда напрямую.
+type Decorator = func(http.Handler) http.Handler
+
+// Pipeline — упорядоченный набор Decorator'ов, который можно компоновать декларативно
+// (например, отдельные Pipeline для Observability, Security, Auth) и применять как
+// единое целое к роутеру целиком или к отдельной группе маршрутов.
+type Pipeline struct {
+	decorators []Decorator
+}
+
+// NewPipeline создает Pipeline из переданных decorator'ов, в порядке применения
+// снаружи внутрь: первый в списке оборачивает все последующие.
+func NewPipeline(decorators ...Decorator) *Pipeline {
+	return &Pipeline{decorators: append([]Decorator{}, decorators...)}
+}
+
+// Use добавляет decorator'ы в конец пайплайна и возвращает Pipeline для чейнинга.
+func (p *Pipeline) Use(decorators ...Decorator) *Pipeline {
+	p.decorators = append(p.decorators, decorators...)
+	return p
+}
+
+// Decorate оборачивает next всеми decorator'ами пайплайна в порядке их добавления.
+func (p *Pipeline) Decorate(next http.Handler) http.Handler {
+	for i := len(p.decorators) - 1; i >= 0; i-- {
+		next = p.decorators[i](next)
+	}
+	return next
+}
@@ -4,17 +4,17 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"os"
 	"time"
 
 	"github.com/Gen-Do/skeleton-service/internal/pkg/env"
+	"github.com/Gen-Do/skeleton-service/internal/pkg/logger"
 	"github.com/Gen-Do/skeleton-service/internal/pkg/metrics"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
-	"github.com/sirupsen/logrus"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
-	"go.opentelemetry.io/otel/trace"
 )
 
 // Config содержит настройки для HTTP сервера
@@ -37,10 +37,12 @@ func defaultConfig() *Config {
 
 // Server представляет HTTP сервер с настроенными middleware
 type Server struct {
-	config *Config
-	router *chi.Mux
-	server *http.Server
-	logger logrus.FieldLogger
+	config   *Config
+	router   *chi.Mux
+	server   *http.Server
+	logger   logger.Logger
+	metrics  *metrics.Metrics
+	pipeline *Pipeline
 }
 
 // RouteRegistrar интерфейс для регистрации маршрутов
@@ -49,7 +51,7 @@ type RouteRegistrar interface {
 }
 
 // New создает и настраивает HTTP сервер
-func New(metricsCollector *metrics.Metrics, logger logrus.FieldLogger) *Server {
+func New(metricsCollector *metrics.Metrics, log logger.Logger) *Server {
 	config := defaultConfig()
 	router := chi.NewRouter()
 
@@ -62,10 +64,11 @@ func New(metricsCollector *metrics.Metrics, logger logrus.FieldLogger) *Server {
 	}
 
 	srv := &Server{
-		config: config,
-		router: router,
-		server: server,
-		logger: logger,
+		config:  config,
+		router:  router,
+		server:  server,
+		logger:  log,
+		metrics: metricsCollector,
 	}
 
 	srv.SetupMiddleware(true)
@@ -73,11 +76,15 @@ func New(metricsCollector *metrics.Metrics, logger logrus.FieldLogger) *Server {
 	return srv
 }
 
-// SetupMiddleware настраивает стандартные middleware
+// SetupMiddleware настраивает стандартные middleware. Стек строится через Pipeline,
+// чтобы порядок групп middleware (Security, Observability) был явным: группы
+// сливаются в один Pipeline, и s.Pipeline().Use(...) до вызова Start/StartAsync
+// добавляет decorator'ы только в его конец — после Recoverer, ближе всего к роутеру.
+// Вставить decorator между группами или перед OpenTelemetry этот метод не позволяет.
 func (s *Server) SetupMiddleware(enableCORS bool) {
-	// CORS middleware
+	security := NewPipeline()
 	if enableCORS {
-		s.router.Use(cors.Handler(cors.Options{
+		security.Use(cors.Handler(cors.Options{
 			AllowedOrigins:   []string{"*"},
 			AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
 			AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
@@ -87,17 +94,29 @@ func (s *Server) SetupMiddleware(enableCORS bool) {
 		}))
 	}
 
-    // Базовые middleware
-    s.router.Use(middleware.RequestID)
-    s.router.Use(middleware.RealIP)
+	observability := NewPipeline(
+		middleware.RequestID,
+		middleware.RealIP,
+		// OpenTelemetry middleware должно идти до логирования,
+		// чтобы в контексте уже был установлен trace/span для логов
+		otelhttp.NewMiddleware("http-server"),
+		// Логирование запросов и сбор метрик. Metrics.Middleware читает
+		// RouteContext().RoutePattern() после next.ServeHTTP, когда chi уже
+		// сопоставил маршрут; RouteContext для этого заводит Start.
+		s.loggingMiddleware(),
+	)
+	if s.metrics != nil {
+		observability.Use(s.metrics.Middleware())
+	}
 
-    // OpenTelemetry middleware должно идти до логирования,
-    // чтобы в контексте уже был установлен trace/span для логов
-    s.router.Use(otelhttp.NewMiddleware("http-server"))
+	s.pipeline = NewPipeline().Use(security.decorators...).Use(observability.decorators...).Use(middleware.Recoverer)
+}
 
-    // Логирование запросов и обработка паник
-    s.router.Use(s.loggingMiddleware())
-    s.router.Use(middleware.Recoverer)
+// Pipeline возвращает пайплайн стандартных middleware сервера, чтобы вызывающий код
+// мог заглянуть в его состав или построить на его основе Pipeline для конкретной
+// группы маршрутов.
+func (s *Server) Pipeline() *Pipeline {
+	return s.pipeline
 }
 
 // AddMiddleware добавляет кастомное middleware
@@ -107,6 +126,19 @@ func (s *Server) AddMiddleware(middlewares ...func(http.Handler) http.Handler) {
 	}
 }
 
+// RouteWithPipeline создает группу маршрутов pattern, к которой применяется указанный
+// Pipeline перед регистрацией маршрутов через fn. Это позволяет декларативно собирать
+// middleware для отдельных групп роутов (например, отдельный Auth-пайплайн для
+// приватного API), не трогая глобальный стек SetupMiddleware.
+func (s *Server) RouteWithPipeline(pattern string, pipeline *Pipeline, fn func(r chi.Router)) {
+	s.router.Route(pattern, func(r chi.Router) {
+		if pipeline != nil {
+			r.Use(pipeline.decorators...)
+		}
+		fn(r)
+	})
+}
+
 // RegisterRoutes регистрирует маршруты через RouteRegistrar
 func (s *Server) RegisterRoutes(registrar RouteRegistrar) {
 	registrar.RegisterRoutes(s.router)
@@ -157,9 +189,20 @@ func (s *Server) AddHealthCheck(path string) {
 	})
 }
 
-// Start запускает HTTP сервер
+// Start запускает HTTP сервер. Стек из Pipeline() применяется поверх роутера только
+// здесь, а не во время New(), — поэтому вызов s.Pipeline().Use(...) до Start/StartAsync
+// действительно меняет итоговый стек, а не мутирует срез, который роутер уже не перечитает.
 func (s *Server) Start() error {
-	s.logger.WithField("port", s.config.Port).Info("Starting HTTP server")
+	decorated := s.pipeline.Decorate(s.router)
+	// Стек оборачивает s.router снаружи, а RouteContext, который chi заводит сам внутри
+	// Mux.ServeHTTP, до внешних middleware не доходит. Поэтому RouteContext кладется в
+	// контекст заранее: chi использует уже существующий, и Metrics.Middleware видит
+	// сопоставленный RoutePattern.
+	s.server.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), chi.RouteCtxKey, chi.NewRouteContext())
+		decorated.ServeHTTP(w, r.WithContext(ctx))
+	})
+	s.logger.Info(context.Background(), "Starting HTTP server", logger.F("port", s.config.Port))
 	return s.server.ListenAndServe()
 }
 
@@ -167,14 +210,15 @@ func (s *Server) Start() error {
 func (s *Server) StartAsync() {
 	go func() {
 		if err := s.Start(); err != nil && err != http.ErrServerClosed {
-			s.logger.WithError(err).Fatal("Failed to start server")
+			s.logger.Error(context.Background(), "Failed to start server", logger.F("error", err))
+			os.Exit(1)
 		}
 	}()
 }
 
 // Shutdown корректно останавливает сервер
 func (s *Server) Shutdown(ctx context.Context) error {
-	s.logger.Info("Shutting down HTTP server...")
+	s.logger.Info(ctx, "Shutting down HTTP server...")
 	return s.server.Shutdown(ctx)
 }
 
@@ -188,7 +232,8 @@ func (s *Server) GetConfig() *Config {
 	return s.config
 }
 
-// loggingMiddleware создает middleware для логирования HTTP запросов
+// loggingMiddleware создает middleware для логирования HTTP запросов. trace_id/span_id
+// в лог добавлять вручную не нужно — Logger сам извлекает их из контекста запроса.
 func (s *Server) loggingMiddleware() func(next http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -197,23 +242,18 @@ func (s *Server) loggingMiddleware() func(next http.Handler) http.Handler {
 			// Создаем wrapped response writer для получения статус кода
 			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
 
-			// Получаем trace context
-			span := trace.SpanFromContext(r.Context())
-			traceID := span.SpanContext().TraceID().String()
-
 			// Обрабатываем запрос
 			next.ServeHTTP(ww, r)
 
 			// Логируем запрос
-			s.logger.WithFields(logrus.Fields{
-				"method":      r.Method,
-				"path":        r.URL.Path,
-				"status":      ww.Status(),
-				"duration_ms": time.Since(start).Milliseconds(),
-				"remote_addr": r.RemoteAddr,
-				"user_agent":  r.UserAgent(),
-				"trace_id":    traceID,
-			}).Info("HTTP request processed")
+			s.logger.Info(r.Context(), "HTTP request processed",
+				logger.F("method", r.Method),
+				logger.F("path", r.URL.Path),
+				logger.F("status", ww.Status()),
+				logger.F("duration_ms", time.Since(start).Milliseconds()),
+				logger.F("remote_addr", r.RemoteAddr),
+				logger.F("user_agent", r.UserAgent()),
+			)
 		})
 	}
 }
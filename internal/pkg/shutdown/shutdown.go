@@ -2,15 +2,20 @@ package shutdown
 
 import (
 	"context"
-	"github.com/Gen-Do/skeleton-service/internal/pkg/server"
-	"github.com/sirupsen/logrus"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
+
+	"github.com/Gen-Do/skeleton-service/internal/pkg/logging"
+	"github.com/Gen-Do/skeleton-service/internal/pkg/server"
 )
 
-func GracefulShutdown(srv *server.Server, logger logrus.FieldLogger) {
+// GracefulShutdown блокируется до получения SIGINT/SIGTERM, затем останавливает srv
+// не дольше 30 секунд, логируя через log.
+func GracefulShutdown(srv *server.Server, log logging.Logger) {
+	ctx := context.Background()
+
 	// Создание канала для получения сигналов ОС
 	quit := make(chan os.Signal, 1)
 
@@ -19,17 +24,17 @@ func GracefulShutdown(srv *server.Server, logger logrus.FieldLogger) {
 
 	// Блокируем выполнение до получения сигнала
 	<-quit
-	logger.Info("Shutting down server...")
+	log.Info(ctx, "Shutting down server...")
 
 	// Создание контекста с таймаутом для завершения работы
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	shutdownCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	// Попытка корректного завершения работы сервера
-	if err := srv.Shutdown(ctx); err != nil {
-		logger.WithError(err).Error("Server forced to shutdown")
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		log.Error(ctx, "Server forced to shutdown", logging.F("error", err))
 		return
 	}
 
-	logger.Info("Server exited gracefully")
+	log.Info(ctx, "Server exited gracefully")
 }
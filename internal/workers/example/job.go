@@ -0,0 +1,26 @@
+package example
+
+import (
+	"context"
+
+	"github.com/Gen-Do/skeleton-service/internal/pkg/jobs"
+)
+
+// exampleJob демонстрирует jobs.Job: регистрируется в глобальном реестре через
+// init(), так что Scheduler может поставить его на cron-расписание или запустить
+// вручную через admin-эндпоинт /jobs, без какого-либо wiring в cmd/main.go.
+type exampleJob struct{}
+
+func init() {
+	jobs.Register(exampleJob{})
+}
+
+// Name возвращает имя, под которым job виден в реестре и в /jobs.
+func (exampleJob) Name() string {
+	return "example"
+}
+
+// Run ничего не делает — job существует только как образец для Scheduler.
+func (exampleJob) Run(_ context.Context, _ jobs.Payload) error {
+	return nil
+}
@@ -4,7 +4,8 @@ import (
 	"context"
 	"time"
 
-	"github.com/Gen-Do/lib-observability/logger"
+	"github.com/Gen-Do/skeleton-service/internal/pkg/logger"
+	"github.com/Gen-Do/skeleton-service/internal/pkg/periodic"
 )
 
 type Worker struct {
@@ -15,17 +16,20 @@ func NewWorker(logger logger.Logger) Worker {
 	return Worker{logger: logger}
 }
 
-func (w Worker) Run(ctx context.Context) error {
-	w.logger.Info(ctx, "Worker started")
-	for {
-		select {
-		case <-ctx.Done():
-			w.logger.Info(ctx, "Worker stopped")
-			return ctx.Err()
-		default:
-			w.logger.Info(ctx, "Example worker tick")
-			time.Sleep(1 * time.Second)
-		}
-	}
-	// Если воркер завершит работу - весь контекст будет завершен
+// RegisterWorkers реализует periodic.WorkerRegistrar, добавляя в Manager периодический
+// воркер с интервалом в одну секунду и небольшим джиттером. Планирование,
+// перезапуск с откатом и graceful shutdown берет на себя periodic.Manager.
+func (w Worker) RegisterWorkers(m *periodic.Manager) {
+	m.Add(periodic.Periodic{
+		Name:     "example",
+		Interval: time.Second,
+		Jitter:   100 * time.Millisecond,
+		Backoff:  periodic.DefaultBackoff(),
+		Run:      w.tick,
+	})
+}
+
+func (w Worker) tick(ctx context.Context) error {
+	w.logger.Info(ctx, "Example worker tick")
+	return nil
 }
@@ -0,0 +1,7 @@
+// Package client is the typed HTTP client SDK for skeleton-service, generated
+// from api/openapi.yaml by oapi-codegen; see api/oapi-codegen.client.config.yaml
+// and `make generate`. Downstream services import this package instead of
+// hand-rolling requests against skeleton-service's API.
+package client
+
+//go:generate go run github.com/deepmap/oapi-codegen/v2/cmd/oapi-codegen --config=../../api/oapi-codegen.client.config.yaml ../../api/openapi.yaml